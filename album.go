@@ -0,0 +1,118 @@
+// Copyright (c) 2022 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+)
+
+// MaxAlbumItems is the maximum number of images and videos that can be sent as a single album.
+const MaxAlbumItems = 30
+
+// MediaItem is a single item in an album. Exactly one of the fields must be set.
+//
+// The media must already be uploaded, e.g. using Client.Upload.
+type MediaItem struct {
+	Image *waProto.ImageMessage
+	Video *waProto.VideoMessage
+}
+
+func (item *MediaItem) toMessage() *waProto.Message {
+	switch {
+	case item.Image != nil && item.Video == nil:
+		return &waProto.Message{ImageMessage: item.Image}
+	case item.Video != nil && item.Image == nil:
+		return &waProto.Message{VideoMessage: item.Video}
+	default:
+		return nil
+	}
+}
+
+// SendAlbumResponse contains the responses of the messages sent by SendAlbum.
+type SendAlbumResponse struct {
+	// The response of the album message that groups the items. Its ID is the AlbumID of the items.
+	Album SendResponse
+	// The responses of the items that were sent, in the same order as they were given.
+	Items []SendResponse
+}
+
+// SendAlbum sends the given images and videos to a chat as an album.
+//
+// An album message with the expected number of images and videos is sent first, then the items are sent one by one
+// in the given order, each linked to the album message. Recipients will see the items grouped together once they
+// arrive, and incoming album items have types.MessageInfo.AlbumID set to the ID of the album message.
+//
+// If sending an item fails, the remaining items are not sent, and the responses of the messages that were already
+// sent are returned along with the error.
+func (cli *Client) SendAlbum(ctx context.Context, chat types.JID, items []MediaItem) (resp SendAlbumResponse, err error) {
+	if len(items) == 0 || len(items) > MaxAlbumItems {
+		err = fmt.Errorf("%w (got %d items, must be between 1 and %d)", ErrInvalidAlbumSize, len(items), MaxAlbumItems)
+		return
+	}
+	messages := make([]*waProto.Message, len(items))
+	var imageCount, videoCount uint32
+	for i, item := range items {
+		messages[i] = item.toMessage()
+		if messages[i] == nil {
+			err = fmt.Errorf("%w (item #%d)", ErrInvalidAlbumItem, i+1)
+			return
+		} else if item.Image != nil {
+			imageCount++
+		} else {
+			videoCount++
+		}
+	}
+	resp.Album, err = cli.SendMessage(ctx, chat, &waProto.Message{
+		AlbumMessage: &waProto.AlbumMessage{
+			ExpectedImageCount: proto.Uint32(imageCount),
+			ExpectedVideoCount: proto.Uint32(videoCount),
+		},
+	})
+	if err != nil {
+		err = fmt.Errorf("failed to send album message: %w", err)
+		return
+	}
+	parentKey := &waProto.MessageKey{
+		RemoteJid: proto.String(chat.String()),
+		FromMe:    proto.Bool(true),
+		Id:        proto.String(resp.Album.ID),
+	}
+	resp.Items = make([]SendResponse, 0, len(messages))
+	for i, msg := range messages {
+		msg.MessageContextInfo = &waProto.MessageContextInfo{
+			MessageAssociation: &waProto.MessageAssociation{
+				AssociationType:  waProto.MessageAssociation_MEDIA_ALBUM.Enum(),
+				ParentMessageKey: parentKey,
+			},
+		}
+		var itemResp SendResponse
+		itemResp, err = cli.SendMessage(ctx, chat, msg)
+		if err != nil {
+			err = fmt.Errorf("failed to send item #%d: %w", i+1, err)
+			return
+		}
+		resp.Items = append(resp.Items, itemResp)
+	}
+	return
+}
+
+// getAlbumID returns the ID of the album message that the given message belongs to, if any.
+func getAlbumID(msgs ...*waProto.Message) types.MessageID {
+	for _, msg := range msgs {
+		association := msg.GetMessageContextInfo().GetMessageAssociation()
+		if association.GetAssociationType() == waProto.MessageAssociation_MEDIA_ALBUM {
+			return association.GetParentMessageKey().GetId()
+		}
+	}
+	return ""
+}
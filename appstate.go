@@ -57,6 +57,7 @@ func (cli *Client) FetchAppState(name appstate.WAPatchName, fullSync, onlyIfNotS
 		mutations, newState, err := cli.appStateProc.DecodePatches(patches, state, true)
 		if err != nil {
 			if errors.Is(err, appstate.ErrKeyNotFound) {
+				cli.Log.Warnf("Missing app state sync key for %s, requesting it from the primary device and retrying after it arrives", name)
 				go cli.requestMissingAppStateKeys(context.TODO(), patches)
 			}
 			return fmt.Errorf("failed to decode app state %s patches: %w", name, err)
@@ -116,6 +117,10 @@ func (cli *Client) dispatchAppState(mutation appstate.Mutation, fullSync bool, e
 	if dispatchEvts {
 		cli.dispatchEvent(&events.AppState{Index: mutation.Index, SyncActionValue: mutation.Action})
 	}
+	if len(mutation.Index) == 0 {
+		cli.Log.Warnf("Ignoring app state mutation with empty index")
+		return
+	}
 
 	var jid types.JID
 	if len(mutation.Index) > 1 {
@@ -130,7 +135,9 @@ func (cli *Client) dispatchAppState(mutation appstate.Mutation, fullSync bool, e
 		act := mutation.Action.GetMuteAction()
 		eventToDispatch = &events.Mute{JID: jid, Timestamp: ts, Action: act, FromFullSync: fullSync}
 		var mutedUntil time.Time
-		if act.GetMuted() {
+		if act.GetMuted() && act.GetMuteEndTimestamp() <= 0 {
+			mutedUntil = types.MutedForever()
+		} else if act.GetMuted() {
 			mutedUntil = time.UnixMilli(act.GetMuteEndTimestamp())
 		}
 		if cli.Store.ChatSettings != nil {
@@ -176,6 +183,15 @@ func (cli *Client) dispatchAppState(mutation appstate.Mutation, fullSync bool, e
 			evt.SenderJID, _ = types.ParseJID(mutation.Index[4])
 		}
 		eventToDispatch = &evt
+		if cli.Store.Stars != nil {
+			storeUpdateError = cli.Store.Stars.PutStarredMessage(store.StarredMessage{
+				Chat:      jid,
+				Sender:    evt.SenderJID,
+				MessageID: evt.MessageID,
+				IsFromMe:  evt.IsFromMe,
+				Timestamp: ts,
+			}, evt.Action.GetStarred())
+		}
 	case appstate.IndexDeleteMessageForMe:
 		if len(mutation.Index) < 5 {
 			return
@@ -223,6 +239,14 @@ func (cli *Client) dispatchAppState(mutation appstate.Mutation, fullSync bool, e
 			Action:       mutation.Action.GetUserStatusMuteAction(),
 			FromFullSync: fullSync,
 		}
+	case appstate.IndexStatusPrivacy:
+		act := mutation.Action.GetStatusPrivacy()
+		eventToDispatch = &events.StatusPrivacy{
+			Timestamp:    ts,
+			Privacy:      parseStatusPrivacyAction(act),
+			Action:       act,
+			FromFullSync: fullSync,
+		}
 	case appstate.IndexLabelEdit:
 		act := mutation.Action.GetLabelEditAction()
 		eventToDispatch = &events.LabelEdit{
@@ -231,6 +255,18 @@ func (cli *Client) dispatchAppState(mutation appstate.Mutation, fullSync bool, e
 			Action:       act,
 			FromFullSync: fullSync,
 		}
+		if cli.Store.Labels != nil {
+			if act.GetDeleted() {
+				storeUpdateError = cli.Store.Labels.DeleteLabel(mutation.Index[1])
+			} else {
+				storeUpdateError = cli.Store.Labels.PutLabel(types.Label{
+					ID:           mutation.Index[1],
+					Name:         act.GetName(),
+					Color:        act.GetColor(),
+					PredefinedID: act.GetPredefinedId(),
+				})
+			}
+		}
 	case appstate.IndexLabelAssociationChat:
 		if len(mutation.Index) < 3 {
 			return
@@ -244,6 +280,9 @@ func (cli *Client) dispatchAppState(mutation appstate.Mutation, fullSync bool, e
 			Action:       act,
 			FromFullSync: fullSync,
 		}
+		if cli.Store.Labels != nil {
+			storeUpdateError = cli.Store.Labels.PutChatLabel(jid, mutation.Index[1], act.GetLabeled())
+		}
 	case appstate.IndexLabelAssociationMessage:
 		if len(mutation.Index) < 6 {
 			return
@@ -258,6 +297,14 @@ func (cli *Client) dispatchAppState(mutation appstate.Mutation, fullSync bool, e
 			Action:       act,
 			FromFullSync: fullSync,
 		}
+	default:
+		cli.Log.Debugf("Unknown app state mutation type %s", mutation.Index[0])
+		eventToDispatch = &events.AppStateUnknownMutation{
+			Index:        mutation.Index,
+			Action:       mutation.Action,
+			Timestamp:    ts,
+			FromFullSync: fullSync,
+		}
 	}
 	if storeUpdateError != nil {
 		cli.Log.Errorf("Failed to update device store after app state mutation: %v", storeUpdateError)
@@ -340,6 +387,40 @@ func (cli *Client) requestAppStateKeys(ctx context.Context, rawKeyIDs [][]byte)
 	}
 }
 
+// GetAppStateVersions returns the locally stored version of each app state patch name that has been synced.
+// Patch names that haven't been synced at all are not included in the map.
+func (cli *Client) GetAppStateVersions() (map[appstate.WAPatchName]uint64, error) {
+	rawVersions, err := cli.Store.AppState.GetAppStateVersions()
+	if err != nil {
+		return nil, err
+	}
+	versions := make(map[appstate.WAPatchName]uint64, len(rawVersions))
+	for name, version := range rawVersions {
+		versions[appstate.WAPatchName(name)] = version
+	}
+	return versions, nil
+}
+
+// VerifyAppStateHash recomputes the hash of the given app state from the locally stored mutation MACs
+// and compares it to the expected hash. If the expected hash is nil, the locally stored hash is used.
+//
+// If the hashes don't match, the local state is out of sync, and it should be resynced from scratch:
+//
+//	ok, err := cli.VerifyAppStateHash(appstate.WAPatchRegular, nil)
+//	if err == nil && !ok {
+//		err = cli.FetchAppState(appstate.WAPatchRegular, true, false)
+//	}
+func (cli *Client) VerifyAppStateHash(name appstate.WAPatchName, expected *[128]byte) (bool, error) {
+	if expected == nil {
+		_, hash, err := cli.Store.AppState.GetAppStateVersion(string(name))
+		if err != nil {
+			return false, fmt.Errorf("failed to get stored app state hash: %w", err)
+		}
+		expected = &hash
+	}
+	return cli.appStateProc.VerifyHash(name, *expected)
+}
+
 // SendAppState sends the given app state patch, then resyncs that app state type from the server
 // to update local caches and send events for the updates.
 //
@@ -123,6 +123,32 @@ func BuildArchive(target types.JID, archive bool, lastMessageTimestamp time.Time
 	return result
 }
 
+// BuildStar builds an app state patch for starring or unstarring a message.
+//
+// The sender is only used in group chats for messages sent by other users, it can be empty otherwise.
+func BuildStar(target, sender types.JID, messageID types.MessageID, fromMe, starred bool) PatchInfo {
+	isFromMe := "0"
+	if fromMe {
+		isFromMe = "1"
+	}
+	participant := "0"
+	if !fromMe && target.Server == types.GroupServer && !sender.IsEmpty() {
+		participant = sender.ToNonAD().String()
+	}
+	return PatchInfo{
+		Type: WAPatchRegularHigh,
+		Mutations: []MutationInfo{{
+			Index:   []string{IndexStar, target.String(), messageID, isFromMe, participant},
+			Version: 2,
+			Value: &waProto.SyncActionValue{
+				StarAction: &waProto.StarAction{
+					Starred: &starred,
+				},
+			},
+		}},
+	}
+}
+
 func newLabelChatMutation(target types.JID, labelID string, labeled bool) MutationInfo {
 	return MutationInfo{
 		Index:   []string{IndexLabelAssociationChat, labelID, target.String()},
@@ -59,6 +59,20 @@ func (hs *HashState) updateHash(mutations []*waProto.SyncdMutation, getPrevSetVa
 	return warnings, nil
 }
 
+// VerifyHash recomputes the LTHash of the given app state from the value MACs in the store
+// and checks whether it matches the expected hash.
+//
+// A mismatch means the local state has diverged from the server, and the app state should be fully resynced.
+func (proc *Processor) VerifyHash(name WAPatchName, expected [128]byte) (bool, error) {
+	valueMACs, err := proc.Store.AppState.GetAllAppStateValueMACs(string(name))
+	if err != nil {
+		return false, fmt.Errorf("failed to get value MACs: %w", err)
+	}
+	var computed [128]byte
+	lthash.WAPatchIntegrity.SubtractThenAddInPlace(computed[:], nil, valueMACs)
+	return computed == expected, nil
+}
+
 func uint64ToBytes(val uint64) []byte {
 	data := make([]byte, 8)
 	binary.BigEndian.PutUint64(data, val)
@@ -35,6 +35,16 @@ const (
 // AllPatchNames contains all currently known patch state names.
 var AllPatchNames = [...]WAPatchName{WAPatchCriticalBlock, WAPatchCriticalUnblockLow, WAPatchRegularHigh, WAPatchRegular, WAPatchRegularLow}
 
+// IsKnown returns true if the patch name is one of the names in AllPatchNames.
+func (name WAPatchName) IsKnown() bool {
+	for _, knownName := range AllPatchNames {
+		if name == knownName {
+			return true
+		}
+	}
+	return false
+}
+
 // Constants for the first part of app state indexes.
 const (
 	IndexMute                    = "mute"
@@ -52,6 +62,7 @@ const (
 	IndexLabelEdit               = "label_edit"
 	IndexLabelAssociationChat    = "label_jid"
 	IndexLabelAssociationMessage = "label_message"
+	IndexStatusPrivacy           = "status_privacy"
 )
 
 type Processor struct {
@@ -0,0 +1,164 @@
+// Copyright (c) 2022 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+)
+
+// OpusAudioMimetype is the mimetype that WhatsApp uses for voice messages.
+const OpusAudioMimetype = "audio/ogg; codecs=opus"
+
+// WaveformLength is the number of samples in the waveform of a voice message.
+const WaveformLength = 64
+
+// opusSampleRate is the sample rate that Ogg Opus granule positions are always expressed in.
+const opusSampleRate = 48000
+
+type oggOpusInfo struct {
+	Duration    time.Duration
+	PacketSizes []int
+}
+
+// parseOggOpus reads the pages of an Ogg Opus file and returns the duration of the audio and the sizes of all audio packets.
+func parseOggOpus(data []byte) (*oggOpusInfo, error) {
+	var info oggOpusInfo
+	var preSkip uint16
+	var lastGranule int64
+	var packet []byte
+	packetIndex := 0
+	for len(data) > 0 {
+		if len(data) < 27 || !bytes.Equal(data[:4], []byte("OggS")) {
+			return nil, ErrInvalidOggOpus
+		}
+		granule := int64(binary.LittleEndian.Uint64(data[6:14]))
+		segmentCount := int(data[26])
+		if len(data) < 27+segmentCount {
+			return nil, ErrInvalidOggOpus
+		}
+		segmentTable := data[27 : 27+segmentCount]
+		data = data[27+segmentCount:]
+		for _, segmentSize := range segmentTable {
+			if len(data) < int(segmentSize) {
+				return nil, ErrInvalidOggOpus
+			}
+			packet = append(packet, data[:segmentSize]...)
+			data = data[segmentSize:]
+			if segmentSize == 255 {
+				// The packet continues in the next segment
+				continue
+			}
+			switch packetIndex {
+			case 0:
+				if len(packet) < 19 || !bytes.Equal(packet[:8], []byte("OpusHead")) {
+					return nil, ErrInvalidOggOpus
+				}
+				preSkip = binary.LittleEndian.Uint16(packet[10:12])
+			case 1:
+				// OpusTags, not interesting
+			default:
+				info.PacketSizes = append(info.PacketSizes, len(packet))
+			}
+			packetIndex++
+			packet = packet[:0]
+		}
+		// A granule position of -1 means no packet finished on this page
+		if granule >= 0 {
+			lastGranule = granule
+		}
+	}
+	if packetIndex == 0 {
+		return nil, ErrInvalidOggOpus
+	}
+	samples := lastGranule - int64(preSkip)
+	if samples < 0 {
+		samples = 0
+	}
+	info.Duration = time.Duration(samples) * time.Second / opusSampleRate
+	return &info, nil
+}
+
+// generateWaveform approximates a waveform for the voice message UI from the sizes of the Opus packets.
+//
+// The audio isn't decoded, but Opus packets are larger when there's more going on in the audio,
+// which is good enough for rendering the waveform bars. The values are scaled to the 0-100 range.
+func generateWaveform(packetSizes []int) []byte {
+	waveform := make([]byte, WaveformLength)
+	if len(packetSizes) == 0 {
+		return waveform
+	}
+	averages := make([]float64, WaveformLength)
+	for i := range averages {
+		start := i * len(packetSizes) / WaveformLength
+		end := (i + 1) * len(packetSizes) / WaveformLength
+		if end <= start {
+			end = start + 1
+		}
+		var sum int
+		for _, size := range packetSizes[start:end] {
+			sum += size
+		}
+		averages[i] = float64(sum) / float64(end-start)
+	}
+	minVal, maxVal := averages[0], averages[0]
+	for _, val := range averages {
+		minVal = min(minVal, val)
+		maxVal = max(maxVal, val)
+	}
+	if maxVal == minVal {
+		for i := range waveform {
+			waveform[i] = 50
+		}
+		return waveform
+	}
+	for i, val := range averages {
+		waveform[i] = byte((val - minVal) / (maxVal - minVal) * 100)
+	}
+	return waveform
+}
+
+// SendAudio uploads the given Ogg Opus audio and sends it as an audio message.
+//
+// If ptt is true, the audio is sent as a voice message (push-to-talk), which includes a waveform for the UI.
+// Otherwise, it's sent as a normal audio file. The duration is read from the Ogg container in both cases.
+//
+// ErrInvalidOggOpus is returned if the data isn't Opus audio in an Ogg container.
+func (cli *Client) SendAudio(ctx context.Context, to types.JID, oggOpusData []byte, ptt bool, extra ...SendRequestExtra) (resp SendResponse, err error) {
+	info, err := parseOggOpus(oggOpusData)
+	if err != nil {
+		return
+	}
+	uploaded, err := cli.Upload(ctx, oggOpusData, MediaAudio)
+	if err != nil {
+		return
+	}
+	audioMsg := &waProto.AudioMessage{
+		Url:               proto.String(uploaded.URL),
+		DirectPath:        proto.String(uploaded.DirectPath),
+		MediaKey:          uploaded.MediaKey,
+		FileEncSha256:     uploaded.FileEncSHA256,
+		FileSha256:        uploaded.FileSHA256,
+		FileLength:        proto.Uint64(uploaded.FileLength),
+		StreamingSidecar:  uploaded.StreamingSidecar,
+		Mimetype:          proto.String(OpusAudioMimetype),
+		Seconds:           proto.Uint32(uint32(info.Duration.Round(time.Second) / time.Second)),
+		Ptt:               proto.Bool(ptt),
+		MediaKeyTimestamp: proto.Int64(time.Now().Unix()),
+	}
+	if ptt {
+		audioMsg.Waveform = generateWaveform(info.PacketSizes)
+	}
+	return cli.SendMessage(ctx, to, &waProto.Message{AudioMessage: audioMsg}, extra...)
+}
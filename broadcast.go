@@ -11,6 +11,7 @@ import (
 	"fmt"
 
 	waBinary "go.mau.fi/whatsmeow/binary"
+	waProto "go.mau.fi/whatsmeow/binary/proto"
 	"go.mau.fi/whatsmeow/types"
 )
 
@@ -86,6 +87,29 @@ func (cli *Client) getStatusBroadcastRecipients() ([]types.JID, error) {
 	return contactsArray, nil
 }
 
+// parseStatusPrivacyAction converts a status privacy app state action into the format returned by GetStatusPrivacy.
+func parseStatusPrivacyAction(act *waProto.StatusPrivacyAction) types.StatusPrivacy {
+	privacy := types.StatusPrivacy{IsDefault: true}
+	switch act.GetMode() {
+	case waProto.StatusPrivacyAction_ALLOW_LIST:
+		privacy.Type = types.StatusPrivacyTypeWhitelist
+	case waProto.StatusPrivacyAction_DENY_LIST:
+		privacy.Type = types.StatusPrivacyTypeBlacklist
+	default:
+		privacy.Type = types.StatusPrivacyTypeContacts
+	}
+	if privacy.Type != types.StatusPrivacyTypeContacts {
+		privacy.List = make([]types.JID, 0, len(act.GetUserJid()))
+		for _, rawJID := range act.GetUserJid() {
+			jid, err := types.ParseJID(rawJID)
+			if err == nil {
+				privacy.List = append(privacy.List, jid)
+			}
+		}
+	}
+	return privacy
+}
+
 var DefaultStatusPrivacy = []types.StatusPrivacy{{
 	Type:      types.StatusPrivacyTypeContacts,
 	IsDefault: true,
@@ -93,7 +117,9 @@ var DefaultStatusPrivacy = []types.StatusPrivacy{{
 
 // GetStatusPrivacy gets the user's status privacy settings (who to send status broadcasts to).
 //
-// There can be multiple different stored settings, the first one is always the default.
+// There can be multiple different stored settings, the first one is always the default. The allow or deny list
+// is included in List for the whitelist and blacklist types. Changes made on other devices are emitted as
+// events.StatusPrivacy.
 func (cli *Client) GetStatusPrivacy() ([]types.StatusPrivacy, error) {
 	resp, err := cli.sendIQ(infoQuery{
 		Namespace: "status",
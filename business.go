@@ -0,0 +1,218 @@
+// Copyright (c) 2022 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"strconv"
+	"strings"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+)
+
+// CatalogImageSize is the width and height of product images requested from the server.
+const CatalogImageSize = 100
+
+// ParseProductMessage converts the product snapshot in the given product message into a types.Product.
+func ParseProductMessage(msg *waProto.ProductMessage) *types.Product {
+	snapshot := msg.GetProduct()
+	if snapshot == nil {
+		return nil
+	}
+	product := &types.Product{
+		ID:            snapshot.GetProductId(),
+		RetailerID:    snapshot.GetRetailerId(),
+		Name:          snapshot.GetTitle(),
+		Description:   snapshot.GetDescription(),
+		URL:           snapshot.GetUrl(),
+		Currency:      snapshot.GetCurrencyCode(),
+		Price1000:     snapshot.GetPriceAmount1000(),
+		SalePrice1000: snapshot.GetSalePriceAmount1000(),
+	}
+	if msg.BusinessOwnerJid != nil {
+		product.BusinessJID, _ = types.ParseJID(msg.GetBusinessOwnerJid())
+	}
+	return product
+}
+
+// ParseOrderMessage converts the given order message into a types.Order.
+//
+// Order messages only contain the summary of the order. Use Client.GetOrderDetails with the ID and token
+// to fetch the individual items.
+func ParseOrderMessage(msg *waProto.OrderMessage) *types.Order {
+	order := &types.Order{
+		ID:             msg.GetOrderId(),
+		Token:          msg.GetToken(),
+		Title:          msg.GetOrderTitle(),
+		Message:        msg.GetMessage(),
+		Status:         strings.ToLower(msg.GetStatus().String()),
+		ItemCount:      int(msg.GetItemCount()),
+		Currency:       msg.GetTotalCurrencyCode(),
+		TotalPrice1000: msg.GetTotalAmount1000(),
+	}
+	if msg.SellerJid != nil {
+		order.SellerJID, _ = types.ParseJID(msg.GetSellerJid())
+	}
+	return order
+}
+
+func getChildString(node waBinary.Node, tag string) string {
+	child, ok := node.GetOptionalChildByTag(tag)
+	if !ok {
+		return ""
+	}
+	content, _ := child.Content.([]byte)
+	return string(content)
+}
+
+func getChildInt64(node waBinary.Node, tag string) int64 {
+	val, _ := strconv.ParseInt(getChildString(node, tag), 10, 64)
+	return val
+}
+
+func parseProductNode(businessJID types.JID, node waBinary.Node) types.Product {
+	_, isHidden := node.GetOptionalChildByTag("is_hidden")
+	return types.Product{
+		ID:            getChildString(node, "id"),
+		RetailerID:    getChildString(node, "retailer_id"),
+		Name:          getChildString(node, "name"),
+		Description:   getChildString(node, "description"),
+		URL:           getChildString(node, "url"),
+		ImageURL:      getChildString(node.GetChildByTag("media", "image"), "original_image_url"),
+		Currency:      getChildString(node, "currency"),
+		Price1000:     getChildInt64(node, "price"),
+		SalePrice1000: getChildInt64(node.GetChildByTag("sale_price"), "price"),
+		IsHidden:      isHidden,
+		Availability:  getChildString(node, "availability"),
+		BusinessJID:   businessJID,
+	}
+}
+
+func catalogImageSizeNodes() []waBinary.Node {
+	size := []byte(strconv.Itoa(CatalogImageSize))
+	return []waBinary.Node{
+		{Tag: "width", Content: size},
+		{Tag: "height", Content: size},
+	}
+}
+
+// GetCatalog gets a page of products in the catalog of the given business.
+//
+// To get the next page, pass the NextPageCursor from the previous response as the cursor.
+func (cli *Client) GetCatalog(jid types.JID, limit int, cursor string) (*types.ProductCatalog, error) {
+	content := append([]waBinary.Node{{
+		Tag:     "limit",
+		Content: []byte(strconv.Itoa(limit)),
+	}}, catalogImageSizeNodes()...)
+	if cursor != "" {
+		content = append(content, waBinary.Node{Tag: "after", Content: []byte(cursor)})
+	}
+	resp, err := cli.sendIQ(infoQuery{
+		Type:      iqGet,
+		To:        types.ServerJID,
+		Namespace: "w:biz:catalog",
+		Content: []waBinary.Node{{
+			Tag: "product_catalog",
+			Attrs: waBinary.Attrs{
+				"jid":               jid,
+				"allow_shop_source": "true",
+			},
+			Content: content,
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	catalogNode, ok := resp.GetOptionalChildByTag("product_catalog")
+	if !ok {
+		return nil, &ElementMissingError{Tag: "product_catalog", In: "response to catalog query"}
+	}
+	var catalog types.ProductCatalog
+	for _, child := range catalogNode.GetChildrenByTag("product") {
+		catalog.Products = append(catalog.Products, parseProductNode(jid, child))
+	}
+	catalog.NextPageCursor = getChildString(catalogNode.GetChildByTag("paging"), "after")
+	return &catalog, nil
+}
+
+// GetProductByID gets the details of a single product in the catalog of the given business,
+// e.g. the product referenced in a product message.
+func (cli *Client) GetProductByID(jid types.JID, productID string) (*types.Product, error) {
+	resp, err := cli.sendIQ(infoQuery{
+		Type:      iqGet,
+		To:        types.ServerJID,
+		Namespace: "w:biz:catalog",
+		Content: []waBinary.Node{{
+			Tag:   "product",
+			Attrs: waBinary.Attrs{"jid": jid},
+			Content: append([]waBinary.Node{{
+				Tag: "product",
+				Content: []waBinary.Node{{
+					Tag:     "id",
+					Content: []byte(productID),
+				}},
+			}}, catalogImageSizeNodes()...),
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	productNode, ok := resp.GetOptionalChildByTag("product", "product")
+	if !ok {
+		return nil, &ElementMissingError{Tag: "product", In: "response to product query"}
+	}
+	product := parseProductNode(jid, productNode)
+	return &product, nil
+}
+
+// GetOrderDetails gets the items of an order. The order ID and token can be found in order messages (see ParseOrderMessage).
+func (cli *Client) GetOrderDetails(orderID, token string) (*types.Order, error) {
+	resp, err := cli.sendIQ(infoQuery{
+		Type:      iqGet,
+		To:        types.ServerJID,
+		Namespace: "fb:thrift_iq",
+		SMaxID:    "5",
+		Content: []waBinary.Node{{
+			Tag: "order",
+			Attrs: waBinary.Attrs{
+				"op": "get",
+				"id": orderID,
+			},
+			Content: []waBinary.Node{
+				{Tag: "image_dimensions", Content: catalogImageSizeNodes()},
+				{Tag: "token", Content: []byte(token)},
+			},
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	orderNode, ok := resp.GetOptionalChildByTag("order")
+	if !ok {
+		return nil, &ElementMissingError{Tag: "order", In: "response to order details query"}
+	}
+	order := types.Order{
+		ID:    orderID,
+		Token: token,
+	}
+	for _, child := range orderNode.GetChildrenByTag("product") {
+		order.Items = append(order.Items, types.OrderItem{
+			ProductID: getChildString(child, "id"),
+			Name:      getChildString(child, "name"),
+			ImageURL:  getChildString(child.GetChildByTag("image"), "url"),
+			Currency:  getChildString(child, "currency"),
+			Price1000: getChildInt64(child, "price"),
+			Quantity:  int(getChildInt64(child, "quantity")),
+		})
+		order.ItemCount += int(getChildInt64(child, "quantity"))
+	}
+	priceNode := orderNode.GetChildByTag("price")
+	order.Currency = getChildString(priceNode, "currency")
+	order.TotalPrice1000 = getChildInt64(priceNode, "total")
+	return &order, nil
+}
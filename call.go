@@ -7,16 +7,19 @@
 package whatsmeow
 
 import (
+	"time"
+
 	waBinary "go.mau.fi/whatsmeow/binary"
 	"go.mau.fi/whatsmeow/types"
 	"go.mau.fi/whatsmeow/types/events"
 )
 
 func (cli *Client) handleCallEvent(node *waBinary.Node) {
-	go cli.sendAck(node)
+	cli.goTracked(func() { cli.sendAck(node) })
 
 	if len(node.GetChildren()) != 1 {
 		cli.dispatchEvent(&events.UnknownCallEvent{Node: node})
+		cli.dispatchUnhandledNode(node.Tag, node)
 		return
 	}
 	ag := node.AttrGetter()
@@ -28,6 +31,7 @@ func (cli *Client) handleCallEvent(node *waBinary.Node) {
 		CallCreator: cag.JID("call-creator"),
 		CallID:      cag.String("call-id"),
 	}
+	cli.updateCallLog(&basicMeta, &child)
 	switch child.Tag {
 	case "offer":
 		cli.dispatchEvent(&events.CallOffer{
@@ -36,7 +40,8 @@ func (cli *Client) handleCallEvent(node *waBinary.Node) {
 				RemotePlatform: ag.String("platform"),
 				RemoteVersion:  ag.String("version"),
 			},
-			Data: &child,
+			IsVideo: hasVideoChild(&child),
+			Data:    &child,
 		})
 	case "offer_notice":
 		cli.dispatchEvent(&events.CallOfferNotice{
@@ -83,7 +88,156 @@ func (cli *Client) handleCallEvent(node *waBinary.Node) {
 			Reason:        cag.String("reason"),
 			Data:          &child,
 		})
+	case "reject":
+		cli.dispatchEvent(&events.CallReject{
+			BasicCallMeta: basicMeta,
+			Data:          &child,
+		})
 	default:
 		cli.dispatchEvent(&events.UnknownCallEvent{Node: node})
+		cli.dispatchUnhandledNode(node.Tag, node)
 	}
 }
+
+func hasVideoChild(offer *waBinary.Node) bool {
+	_, ok := offer.GetOptionalChildByTag("video")
+	return ok
+}
+
+// callLogMaxAge is how long calls are tracked. Calls that don't receive a terminate element in this time are logged
+// with CallEndReasonUnfinished and forgotten.
+const callLogMaxAge = 2 * time.Hour
+
+type trackedCall struct {
+	log       *events.CallLog
+	firstSeen time.Time
+	// finished is set after the log has been dispatched, so that duplicate or late elements don't dispatch it again.
+	finished bool
+}
+
+// updateCallLog updates the tracked state of a call based on a call element and dispatches
+// an events.CallLog if the call ended.
+func (cli *Client) updateCallLog(meta *types.BasicCallMeta, child *waBinary.Node) {
+	cli.callLogsLock.Lock()
+	defer cli.callLogsLock.Unlock()
+	call, ok := cli.callLogs[meta.CallID]
+	if !ok {
+		switch child.Tag {
+		case "offer", "offer_notice", "accept", "reject", "terminate":
+		default:
+			return
+		}
+		for callID, oldCall := range cli.callLogs {
+			if time.Since(oldCall.firstSeen) > callLogMaxAge {
+				if !oldCall.finished {
+					oldCall.log.EndReason = types.CallEndReasonUnfinished
+					cli.finishCallLog(oldCall, time.Time{})
+				}
+				delete(cli.callLogs, callID)
+			}
+		}
+		call = &trackedCall{
+			log:       &events.CallLog{CallID: meta.CallID, From: meta.From, CallCreator: meta.CallCreator},
+			firstSeen: time.Now(),
+		}
+		cli.callLogs[meta.CallID] = call
+	} else if call.finished {
+		return
+	}
+	callLog := call.log
+	cag := child.AttrGetter()
+	switch child.Tag {
+	case "offer":
+		callLog.OfferedAt = meta.Timestamp
+		callLog.IsVideo = hasVideoChild(child)
+		callLog.GroupJID = cag.OptionalJIDOrEmpty("group-jid")
+		callLog.IsGroup = !callLog.GroupJID.IsEmpty()
+		if groupInfo, ok := child.GetOptionalChildByTag("group_info"); ok {
+			callLog.IsGroup = true
+			for _, participant := range groupInfo.GetChildrenByTag("participant") {
+				if jid, ok := participant.Attrs["jid"].(types.JID); ok {
+					callLog.Participants = append(callLog.Participants, jid)
+				}
+			}
+		}
+	case "offer_notice":
+		callLog.OfferedAt = meta.Timestamp
+		callLog.IsVideo = cag.OptionalString("media") == "video"
+		callLog.IsGroup = callLog.IsGroup || cag.OptionalString("type") == "group"
+	case "accept":
+		callLog.AcceptedAt = meta.Timestamp
+		if meta.From.User == cli.getOwnID().User {
+			callLog.EndReason = types.CallEndReasonAcceptedElsewhere
+		}
+	case "reject":
+		callLog.EndReason = types.CallEndReasonRejected
+		cli.finishCallLog(call, meta.Timestamp)
+	case "terminate":
+		callLog.RawReason = cag.OptionalString("reason")
+		if reason := parseCallEndReason(callLog.RawReason); reason != types.CallEndReasonUnknown {
+			callLog.EndReason = reason
+		} else if callLog.EndReason == types.CallEndReasonUnknown && !callLog.AcceptedAt.IsZero() {
+			callLog.EndReason = types.CallEndReasonEnded
+		}
+		cli.finishCallLog(call, meta.Timestamp)
+	}
+}
+
+// finishCallLog dispatches the log of the given call. The call log lock must be held.
+func (cli *Client) finishCallLog(call *trackedCall, endedAt time.Time) {
+	call.finished = true
+	call.log.EndedAt = endedAt
+	go cli.dispatchEvent(call.log)
+}
+
+func parseCallEndReason(reason string) types.CallEndReason {
+	switch reason {
+	case "timeout":
+		return types.CallEndReasonTimeout
+	case "busy":
+		return types.CallEndReasonBusy
+	case "reject", "rejected", "declined":
+		return types.CallEndReasonRejected
+	case "accepted_elsewhere":
+		return types.CallEndReasonAcceptedElsewhere
+	default:
+		return types.CallEndReasonUnknown
+	}
+}
+
+// RejectCall rejects an incoming call. The call ID and caller can be found in the events.CallOffer event.
+//
+//	cli.AddEventHandler(func(evt interface{}) {
+//		if offer, ok := evt.(*events.CallOffer); ok {
+//			_ = cli.RejectCall(offer.CallID, offer.From)
+//		}
+//	})
+func (cli *Client) RejectCall(callID string, from types.JID) error {
+	ownID := cli.getOwnID()
+	if ownID.IsEmpty() {
+		return ErrNotLoggedIn
+	}
+	ownID, from = ownID.ToNonAD(), from.ToNonAD()
+	cli.callLogsLock.Lock()
+	if call, ok := cli.callLogs[callID]; ok && !call.finished {
+		call.log.EndReason = types.CallEndReasonRejected
+		cli.finishCallLog(call, time.Now())
+	}
+	cli.callLogsLock.Unlock()
+	return cli.sendNode(waBinary.Node{
+		Tag: "call",
+		Attrs: waBinary.Attrs{
+			"id":   cli.GenerateMessageID(),
+			"from": ownID,
+			"to":   from,
+		},
+		Content: []waBinary.Node{{
+			Tag: "reject",
+			Attrs: waBinary.Attrs{
+				"call-id":      callID,
+				"call-creator": from,
+				"count":        "0",
+			},
+		}},
+	})
+}
@@ -0,0 +1,30 @@
+// Copyright (c) 2022 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"time"
+
+	"go.mau.fi/whatsmeow/types"
+)
+
+// IsChatMuted checks whether the given chat is currently muted according to the mute state synced via app state.
+//
+// The returned time is when the chat will be unmuted, or types.MutedForever() if it's muted indefinitely.
+// This is also available as the IsMuted field in *events.Message for incoming messages if Client.CheckChatMuted is enabled.
+func (cli *Client) IsChatMuted(chat types.JID) (muted bool, muteEndTime time.Time, err error) {
+	if cli.Store.ChatSettings == nil {
+		return
+	}
+	settings, err := cli.Store.ChatSettings.GetChatSettings(chat)
+	if err != nil {
+		return
+	} else if settings.MutedUntil.After(cli.ServerTime()) {
+		return true, settings.MutedUntil, nil
+	}
+	return
+}
@@ -32,13 +32,8 @@ func (cli *Client) handleStreamError(node *waBinary.Node) {
 			}
 		}()
 	case code == "401" && conflictType == "device_removed":
-		cli.expectDisconnect()
 		cli.Log.Infof("Got device removed stream error, sending LoggedOut event and deleting session")
-		go cli.dispatchEvent(&events.LoggedOut{OnConnect: false, Reason: events.ConnectFailureLoggedOut})
-		err := cli.Store.Delete()
-		if err != nil {
-			cli.Log.Warnf("Failed to delete store after device_removed error: %v", err)
-		}
+		cli.handleLoggedOut(&events.LoggedOut{OnConnect: false, Reason: events.ConnectFailureLoggedOut, DeviceRemoved: true})
 	case conflictType == "replaced":
 		cli.expectDisconnect()
 		cli.Log.Infof("Got replaced stream error, sending StreamReplaced event")
@@ -63,6 +58,19 @@ func (cli *Client) handleStreamError(node *waBinary.Node) {
 	}
 }
 
+// handleLoggedOut dispatches the given LoggedOut event and deletes the session data,
+// so that the client won't try to reconnect with credentials that are no longer valid.
+func (cli *Client) handleLoggedOut(evt *events.LoggedOut) {
+	cli.expectDisconnect()
+	err := cli.Store.Delete()
+	if err != nil {
+		cli.Log.Warnf("Failed to delete store after logout: %v", err)
+		// Forget the device ID anyway, which marks the device as unpaired and prevents auto-reconnecting
+		cli.Store.ID = nil
+	}
+	go cli.dispatchEvent(evt)
+}
+
 func (cli *Client) handleIB(node *waBinary.Node) {
 	children := node.GetChildren()
 	for _, child := range children {
@@ -71,6 +79,8 @@ func (cli *Client) handleIB(node *waBinary.Node) {
 		case "downgrade_webclient":
 			go cli.dispatchEvent(&events.QRScannedWithoutMultidevice{})
 		case "offline_preview":
+			cli.offlineExpectedMessages.Store(int32(ag.Int("message")))
+			cli.offlineReceivedMessages.Store(0)
 			cli.dispatchEvent(&events.OfflineSyncPreview{
 				Total:          ag.Int("count"),
 				AppDataChanges: ag.Int("appdata"),
@@ -79,9 +89,20 @@ func (cli *Client) handleIB(node *waBinary.Node) {
 				Receipts:       ag.Int("receipt"),
 			})
 		case "offline":
+			expected := int(cli.offlineExpectedMessages.Swap(0))
+			received := int(cli.offlineReceivedMessages.Swap(0))
+			if expected > received {
+				cli.Log.Warnf("Server announced %d offline messages, but only sent %d", expected, received)
+				cli.dispatchEvent(&events.OfflineMessagesDropped{
+					Count: expected - received,
+				})
+			}
 			cli.dispatchEvent(&events.OfflineSyncCompleted{
 				Count: ag.Int("count"),
 			})
+		default:
+			cli.Log.Debugf("Unhandled ib child %s", child.Tag)
+			cli.dispatchUnhandledNode(node.Tag, &child)
 		}
 	}
 }
@@ -114,11 +135,7 @@ func (cli *Client) handleConnectFailure(node *waBinary.Node) {
 	}
 	if reason.IsLoggedOut() {
 		cli.Log.Infof("Got %s connect failure, sending LoggedOut event and deleting session", reason)
-		go cli.dispatchEvent(&events.LoggedOut{OnConnect: true, Reason: reason})
-		err := cli.Store.Delete()
-		if err != nil {
-			cli.Log.Warnf("Failed to delete store after %d failure: %v", int(reason), err)
-		}
+		cli.handleLoggedOut(&events.LoggedOut{OnConnect: true, Reason: reason})
 	} else if reason == events.ConnectFailureTempBanned {
 		cli.Log.Warnf("Temporary ban connect failure: %s", node.XMLString())
 		go cli.dispatchEvent(&events.TemporaryBan{
@@ -149,6 +166,15 @@ func (cli *Client) handleConnectSuccess(node *waBinary.Node) {
 	cli.LastSuccessfulConnect = time.Now()
 	cli.AutoReconnectErrors = 0
 	cli.isLoggedIn.Store(true)
+	cli.updateServerTimeOffset(node.AttrGetter().OptionalUnixTime("t"))
+	if lid := node.AttrGetter().OptionalJIDOrEmpty("lid"); !lid.IsEmpty() && lid.User != cli.Store.LID.User {
+		cli.Log.Debugf("Updating own LID to %s", lid)
+		cli.Store.LID = lid.ToNonAD()
+		err := cli.Store.Save()
+		if err != nil {
+			cli.Log.Warnf("Failed to save device store after updating LID: %v", err)
+		}
+	}
 	go func() {
 		if dbCount, err := cli.Store.PreKeys.UploadedPreKeyCount(); err != nil {
 			cli.Log.Errorf("Failed to get number of prekeys in database: %v", err)
@@ -157,7 +183,7 @@ func (cli *Client) handleConnectSuccess(node *waBinary.Node) {
 		} else {
 			cli.Log.Debugf("Database has %d prekeys, server says we have %d", dbCount, serverCount)
 			if serverCount < MinPreKeyCount || dbCount < MinPreKeyCount {
-				cli.uploadPreKeys()
+				cli.uploadPreKeys(serverCount)
 				sc, _ := cli.getServerPreKeyCount()
 				cli.Log.Debugf("Prekey count after upload: %d", sc)
 			}
@@ -168,6 +194,7 @@ func (cli *Client) handleConnectSuccess(node *waBinary.Node) {
 		}
 		cli.dispatchEvent(&events.Connected{})
 		cli.closeSocketWaitChan()
+		cli.resubscribePresence()
 	}()
 }
 
@@ -0,0 +1,83 @@
+// Copyright (c) 2022 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"context"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+)
+
+// pdfPageRegex matches page objects in a PDF file. The negative part excludes the /Pages tree nodes.
+var pdfPageRegex = regexp.MustCompile(`/Type\s*/Page[^s]`)
+
+// countPDFPages returns the number of pages in the given PDF file.
+//
+// This only counts page objects in the raw file, so it won't find pages inside compressed object streams.
+func countPDFPages(data []byte) uint32 {
+	return uint32(len(pdfPageRegex.FindAllIndex(data, -1)))
+}
+
+// BuildDocument uploads the given file and returns a DocumentMessage that can be sent with Client.SendMessage.
+//
+// If mimetype is empty, it will be guessed from the file extension or the file content.
+// For PDFs, the page count is filled automatically, as WhatsApp needs it to render a preview.
+// Other fields like Caption or JpegThumbnail can be set on the returned message before sending.
+//
+// ErrEmptyDocument is returned if the data is empty.
+func (cli *Client) BuildDocument(ctx context.Context, data []byte, fileName, mimetype string) (*waProto.DocumentMessage, error) {
+	if len(data) == 0 {
+		return nil, ErrEmptyDocument
+	}
+	if mimetype == "" {
+		mimetype = mime.TypeByExtension(filepath.Ext(fileName))
+	}
+	if mimetype == "" {
+		mimetype = http.DetectContentType(data)
+	}
+	uploaded, err := cli.Upload(ctx, data, MediaDocument)
+	if err != nil {
+		return nil, err
+	}
+	docMsg := &waProto.DocumentMessage{
+		Url:               proto.String(uploaded.URL),
+		DirectPath:        proto.String(uploaded.DirectPath),
+		MediaKey:          uploaded.MediaKey,
+		FileEncSha256:     uploaded.FileEncSHA256,
+		FileSha256:        uploaded.FileSHA256,
+		FileLength:        proto.Uint64(uploaded.FileLength),
+		Mimetype:          proto.String(mimetype),
+		Title:             proto.String(fileName),
+		FileName:          proto.String(fileName),
+		MediaKeyTimestamp: proto.Int64(time.Now().Unix()),
+	}
+	if mediaType, _, _ := mime.ParseMediaType(mimetype); mediaType == "application/pdf" {
+		if pageCount := countPDFPages(data); pageCount > 0 {
+			docMsg.PageCount = proto.Uint32(pageCount)
+		}
+	}
+	return docMsg, nil
+}
+
+// SendDocument uploads the given file and sends it as a document message.
+//
+// This is a shorthand for BuildDocument and SendMessage, see BuildDocument for details.
+func (cli *Client) SendDocument(ctx context.Context, to types.JID, data []byte, fileName, mimetype string, extra ...SendRequestExtra) (resp SendResponse, err error) {
+	docMsg, err := cli.BuildDocument(ctx, data, fileName, mimetype)
+	if err != nil {
+		return
+	}
+	return cli.SendMessage(ctx, to, &waProto.Message{DocumentMessage: docMsg}, extra...)
+}
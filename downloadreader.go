@@ -0,0 +1,243 @@
+// Copyright (c) 2022 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"go.mau.fi/whatsmeow/socket"
+)
+
+const (
+	mediaMACLength        = 10
+	downloadReadChunkSize = 32 * 1024
+)
+
+// DownloadReader downloads the attachment from the given protobuf message like DownloadContext,
+// but instead of buffering the whole file in memory, it returns a reader that decrypts the file on the fly.
+// This is useful for large files, which can be piped directly to disk or another network connection.
+//
+// Note that the integrity of the file (the HMAC and hashes) can only be verified after the whole file has been read.
+// If verification fails, the last Read call returns the error instead of io.EOF, and Close returns the same error.
+// The data read before that must therefore be treated as untrusted until the stream has been fully consumed without errors.
+//
+// The reader must always be closed, as it holds the HTTP connection (and a media transfer slot, see
+// Client.MaxConcurrentMediaTransfers) until then. Closing before reading the whole file doesn't return verification errors.
+func (cli *Client) DownloadReader(ctx context.Context, msg DownloadableMessage) (io.ReadCloser, error) {
+	mediaType, ok := classToMediaType[msg.ProtoReflect().Descriptor().Name()]
+	if !ok {
+		return nil, fmt.Errorf("%w '%s'", ErrUnknownMediaType, string(msg.ProtoReflect().Descriptor().Name()))
+	}
+	var urls []string
+	if urlable, ok := msg.(downloadableMessageWithURL); ok && len(urlable.GetUrl()) > 0 && !strings.HasPrefix(urlable.GetUrl(), "https://web.whatsapp.net") {
+		urls = []string{urlable.GetUrl()}
+	} else if len(msg.GetDirectPath()) > 0 {
+		mediaConn, err := cli.refreshMediaConn(false)
+		if err != nil {
+			return nil, fmt.Errorf("failed to refresh media connections: %w", err)
+		}
+		for _, host := range mediaConn.Hosts {
+			urls = append(urls, fmt.Sprintf("https://%s%s&hash=%s&mms-type=%s&__wa-mms=", host.Hostname, msg.GetDirectPath(), base64.URLEncoding.EncodeToString(msg.GetFileEncSha256()), mediaTypeToMMSType[mediaType]))
+		}
+	} else {
+		return nil, ErrNoURLPresent
+	}
+	release, err := cli.acquireMediaTransferSlot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var body io.ReadCloser
+	for i, url := range urls {
+		body, err = cli.openMediaStream(ctx, url)
+		if err == nil || ctx.Err() != nil {
+			break
+		} else if i < len(urls)-1 {
+			cli.Log.Warnf("Failed to start media download: %s, trying with next host...", err)
+		}
+	}
+	if err != nil {
+		release()
+		return nil, err
+	}
+	if msg.GetMediaKey() == nil && msg.GetFileEncSha256() == nil {
+		return &plainDownloadReader{ReadCloser: body, release: release}, nil
+	}
+	return newDownloadReader(body, release, msg.GetMediaKey(), mediaType, getSize(msg), msg.GetFileEncSha256(), msg.GetFileSha256())
+}
+
+func (cli *Client) openMediaStream(ctx context.Context, url string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare request: %w", err)
+	}
+	req.Header.Set("Origin", socket.Origin)
+	req.Header.Set("Referer", socket.Origin+"/")
+	if cli.MessengerConfig != nil {
+		req.Header.Set("User-Agent", cli.MessengerConfig.UserAgent)
+	}
+	resp, err := cli.http.Do(req)
+	if err != nil {
+		return nil, err
+	} else if resp.StatusCode != http.StatusOK {
+		_ = resp.Body.Close()
+		return nil, DownloadHTTPError{Response: resp}
+	}
+	return resp.Body, nil
+}
+
+type plainDownloadReader struct {
+	io.ReadCloser
+	release     func()
+	releaseOnce sync.Once
+}
+
+func (pdr *plainDownloadReader) Close() error {
+	pdr.releaseOnce.Do(pdr.release)
+	return pdr.ReadCloser.Close()
+}
+
+// downloadReader decrypts media while it's being read. The last block and the MAC are always held back,
+// so that the padding can be removed and the file can be verified before returning the last bytes.
+type downloadReader struct {
+	body        io.ReadCloser
+	release     func()
+	releaseOnce sync.Once
+
+	cbc       cipher.BlockMode
+	mac       hash.Hash
+	encHash   hash.Hash
+	plainHash hash.Hash
+
+	fileLength  int
+	readLength  int
+	fileEncHash []byte
+	fileHash    []byte
+
+	encBuf   []byte
+	plainBuf []byte
+	readBuf  []byte
+	finished bool
+	err      error
+}
+
+func newDownloadReader(body io.ReadCloser, release func(), mediaKey []byte, mediaType MediaType, fileLength int, fileEncSha256, fileSha256 []byte) (*downloadReader, error) {
+	iv, cipherKey, macKey, _ := getMediaKeys(mediaKey, mediaType)
+	block, err := aes.NewCipher(cipherKey)
+	if err != nil {
+		_ = body.Close()
+		release()
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	dr := &downloadReader{
+		body:        body,
+		release:     release,
+		cbc:         cipher.NewCBCDecrypter(block, iv),
+		mac:         hmac.New(sha256.New, macKey),
+		encHash:     sha256.New(),
+		plainHash:   sha256.New(),
+		fileLength:  fileLength,
+		fileEncHash: fileEncSha256,
+		fileHash:    fileSha256,
+		readBuf:     make([]byte, downloadReadChunkSize),
+	}
+	dr.mac.Write(iv)
+	return dr, nil
+}
+
+func (dr *downloadReader) decryptBlocks(ciphertext []byte) {
+	dr.mac.Write(ciphertext)
+	plaintext := make([]byte, len(ciphertext))
+	dr.cbc.CryptBlocks(plaintext, ciphertext)
+	dr.plainBuf = append(dr.plainBuf, plaintext...)
+}
+
+func (dr *downloadReader) emit(plaintext []byte) {
+	dr.plainHash.Write(plaintext)
+	dr.readLength += len(plaintext)
+}
+
+func (dr *downloadReader) finish() error {
+	ciphertextLength := len(dr.encBuf) - mediaMACLength
+	if ciphertextLength < aes.BlockSize || ciphertextLength%aes.BlockSize != 0 {
+		return ErrTooShortFile
+	}
+	mac := dr.encBuf[ciphertextLength:]
+	if len(dr.fileEncHash) == 32 && !bytes.Equal(dr.encHash.Sum(nil), dr.fileEncHash) {
+		return ErrInvalidMediaEncSHA256
+	}
+	dr.decryptBlocks(dr.encBuf[:ciphertextLength])
+	dr.encBuf = nil
+	if !hmac.Equal(dr.mac.Sum(nil)[:mediaMACLength], mac) {
+		return ErrInvalidMediaHMAC
+	}
+	padding := int(dr.plainBuf[len(dr.plainBuf)-1])
+	if padding == 0 || padding > aes.BlockSize || padding > len(dr.plainBuf) {
+		return fmt.Errorf("failed to decrypt file: invalid padding")
+	}
+	dr.plainBuf = dr.plainBuf[:len(dr.plainBuf)-padding]
+	dr.emit(dr.plainBuf)
+	if dr.fileLength >= 0 && dr.readLength != dr.fileLength {
+		return fmt.Errorf("%w: expected %d, got %d", ErrFileLengthMismatch, dr.fileLength, dr.readLength)
+	} else if len(dr.fileHash) == 32 && !bytes.Equal(dr.plainHash.Sum(nil), dr.fileHash) {
+		return ErrInvalidMediaSHA256
+	}
+	return nil
+}
+
+func (dr *downloadReader) Read(p []byte) (int, error) {
+	for {
+		if len(dr.plainBuf) > 0 && (!dr.finished || dr.err == nil) {
+			n := copy(p, dr.plainBuf)
+			dr.plainBuf = dr.plainBuf[n:]
+			return n, nil
+		} else if dr.finished {
+			if dr.err != nil {
+				return 0, dr.err
+			}
+			return 0, io.EOF
+		}
+		n, err := dr.body.Read(dr.readBuf)
+		dr.encHash.Write(dr.readBuf[:n])
+		dr.encBuf = append(dr.encBuf, dr.readBuf[:n]...)
+		if err == io.EOF {
+			dr.finished = true
+			dr.err = dr.finish()
+			continue
+		} else if err != nil {
+			return 0, err
+		}
+		// Keep the MAC and the last block (which contains padding) in the buffer until the end of the stream
+		decryptable := len(dr.encBuf) - mediaMACLength - aes.BlockSize
+		decryptable -= decryptable % aes.BlockSize
+		if decryptable > 0 {
+			dr.decryptBlocks(dr.encBuf[:decryptable])
+			dr.emit(dr.plainBuf[len(dr.plainBuf)-decryptable:])
+			dr.encBuf = append(dr.encBuf[:0], dr.encBuf[decryptable:]...)
+		}
+	}
+}
+
+func (dr *downloadReader) Close() error {
+	dr.releaseOnce.Do(dr.release)
+	err := dr.body.Close()
+	if dr.finished && dr.err != nil {
+		return dr.err
+	}
+	return err
+}
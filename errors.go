@@ -10,19 +10,23 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/types"
 )
 
 // Miscellaneous errors
 var (
-	ErrNoSession       = errors.New("can't encrypt message for device: no signal session established")
-	ErrIQTimedOut      = errors.New("info query timed out")
-	ErrNotConnected    = errors.New("websocket not connected")
-	ErrNotLoggedIn     = errors.New("the store doesn't contain a device JID")
+	ErrNoSession    = errors.New("can't encrypt message for device: no signal session established")
+	ErrIQTimedOut   = errors.New("info query timed out")
+	ErrNotConnected = errors.New("websocket not connected")
+	ErrNotLoggedIn  = errors.New("the store doesn't contain a device JID")
+	// ErrMessageTimedOut is returned by SendMessage wrapped in a *NoAckError, so it must be checked with errors.Is.
 	ErrMessageTimedOut = errors.New("timed out waiting for message send response")
 
-	ErrAlreadyConnected = errors.New("websocket is already connected")
+	ErrAlreadyConnected    = errors.New("websocket is already connected")
+	ErrInvalidWebsocketURL = errors.New("invalid websocket URL")
 
 	ErrQRAlreadyConnected = errors.New("GetQRChannel must be called before connecting")
 	ErrQRStoreContainsID  = errors.New("GetQRChannel can only be called when there's no user ID in the client's Store")
@@ -39,6 +43,8 @@ var (
 	ErrPairInvalidDeviceIdentityHMAC = errors.New("invalid device identity HMAC in pair success message")
 	ErrPairInvalidDeviceSignature    = errors.New("invalid device signature in pair success message")
 	ErrPairRejectedLocally           = errors.New("local PrePairCallback rejected pairing")
+	ErrPairTimeout                   = errors.New("server closed the connection before the QR code was scanned")
+	ErrPairDisconnected              = errors.New("connection was lost before the QR code was scanned")
 )
 
 // PairProtoError is included in an events.PairError if the pairing failed due to a protobuf error.
@@ -90,14 +96,55 @@ var (
 	ErrBusinessMessageLinkNotFound = errors.New("that business message link does not exist or has been revoked")
 	// ErrContactQRLinkNotFound is returned by ResolveContactQRLink if the link doesn't exist or has been revoked.
 	ErrContactQRLinkNotFound = errors.New("that contact QR link does not exist or has been revoked")
+	// ErrContactQRLinkRevoked is returned by ResolveContactQRLink if the link was valid, but has been revoked by its owner.
+	ErrContactQRLinkRevoked = errors.New("that contact QR link has been revoked")
+	// ErrContactQRLinkInvalid is returned by ResolveContactQRLink if the given code is malformed.
+	ErrContactQRLinkInvalid = errors.New("that contact QR link is not valid")
 	// ErrInvalidImageFormat is returned by SetGroupPhoto if the given photo is not in the correct format.
 	ErrInvalidImageFormat = errors.New("the given data is not a valid image")
 	// ErrMediaNotAvailableOnPhone is returned by DecryptMediaRetryNotification if the given event contains error code 2.
 	ErrMediaNotAvailableOnPhone = errors.New("media no longer available on phone")
 	// ErrUnknownMediaRetryError is returned by DecryptMediaRetryNotification if the given event contains an unknown error code.
 	ErrUnknownMediaRetryError = errors.New("unknown media retry error")
-	// ErrInvalidDisappearingTimer is returned by SetDisappearingTimer if the given timer is not one of the allowed values.
+	// ErrInvalidDisappearingTimer is returned by SetDisappearingTimer and SendMessage (with SendRequestExtra.Expiration)
+	// if the given timer is not one of the allowed values.
 	ErrInvalidDisappearingTimer = errors.New("invalid disappearing timer provided")
+	// ErrViewOnceUnsupportedMessage is returned by BuildViewOnce if the given message isn't an image, video or audio message.
+	ErrViewOnceUnsupportedMessage = errors.New("view once is only supported for image, video and audio messages")
+	// ErrInvalidOggOpus is returned by SendAudio if the given data is not Opus audio in an Ogg container.
+	ErrInvalidOggOpus = errors.New("the given data is not valid ogg opus audio")
+	// ErrInvalidWebP is returned by SendSticker if the given data is not a WebP image.
+	ErrInvalidWebP = errors.New("the given data is not a valid webp image")
+	// ErrNoVideoFrameExtractor is returned by AddVideoThumbnail if Client.VideoFrameExtractor is not set.
+	ErrNoVideoFrameExtractor = errors.New("no video frame extractor configured")
+	// ErrEmptyDocument is returned by BuildDocument and SendDocument if the given file is empty.
+	ErrEmptyDocument = errors.New("can't send empty document")
+	// ErrInvalidPushName is returned by SetPushName if the given name is empty or too long.
+	ErrInvalidPushName = errors.New("push name must be between 1 and 25 characters")
+	// ErrUnsupportedProxyScheme is returned by SetProxyAddress if the given URL isn't a HTTP(S) or SOCKS5 proxy.
+	ErrUnsupportedProxyScheme = errors.New("unsupported proxy scheme")
+	// ErrInvalidDeviceName is returned by SetDeviceProps if the device name is empty.
+	ErrInvalidDeviceName = errors.New("device name must not be empty")
+	// ErrInvalidDevicePlatform is returned by SetDeviceProps if the platform isn't a known DeviceProps_PlatformType.
+	ErrInvalidDevicePlatform = errors.New("unknown device platform type")
+	// ErrNewsletterNameTaken is returned by CreateNewsletter and UpdateNewsletterName if the name is already in use.
+	ErrNewsletterNameTaken = errors.New("that newsletter name is already taken")
+	// ErrNewsletterRateLimited is returned by newsletter admin methods if the server rejected the change due to rate limits.
+	ErrNewsletterRateLimited = errors.New("too many newsletter changes, try again later")
+	// ErrRateLimited is returned by SendMessage if Client.SendRateLimit is exceeded and Client.SendRateLimitNoWait is set.
+	ErrRateLimited = errors.New("outgoing message rate limit exceeded")
+	// ErrInvalidAlbumSize is returned by SendAlbum if there are no items or more than MaxAlbumItems items.
+	ErrInvalidAlbumSize = errors.New("invalid number of album items")
+	// ErrInvalidAlbumItem is returned by SendAlbum if an item doesn't contain exactly one image or video.
+	ErrInvalidAlbumItem = errors.New("album items must contain exactly one image or video")
+	// ErrNoLabelStore is returned by the label methods if the device store doesn't support storing labels.
+	ErrNoLabelStore = errors.New("device store doesn't support storing labels")
+	// ErrNoVerifiedIdentityStore is returned by SetIdentityVerified if the device store doesn't support verified identities.
+	ErrNoVerifiedIdentityStore = errors.New("device store doesn't support storing verified identities")
+	// ErrNoReceiptStore is returned by GetMessageReceipts and PruneMessageReceipts if the device store doesn't support storing receipts.
+	ErrNoReceiptStore = errors.New("device store doesn't support storing message receipts")
+	// ErrNoPollStore is returned by GetPollResults if the device store doesn't support storing polls.
+	ErrNoPollStore = errors.New("device store doesn't support storing polls")
 )
 
 // Some errors that Client.SendMessage can return
@@ -106,6 +153,10 @@ var (
 	ErrUnknownServer            = errors.New("can't send message to unknown server")
 	ErrRecipientADJID           = errors.New("message recipient must be a user JID with no device part")
 	ErrServerReturnedError      = errors.New("server returned error")
+	ErrPreSendHookRejected      = errors.New("pre-send hook rejected message")
+	ErrInvalidTargetDevice      = errors.New("invalid target device")
+	ErrNoAck                    = errors.New("server didn't acknowledge the message")
+	ErrNotNewsletterAdmin       = errors.New("you're not an admin of that newsletter")
 )
 
 type DownloadHTTPError struct {
@@ -140,6 +191,7 @@ var (
 	ErrOriginalMessageSecretNotFound = errors.New("original message secret key not found")
 	ErrNotEncryptedReactionMessage   = errors.New("given message isn't an encrypted reaction message")
 	ErrNotPollUpdateMessage          = errors.New("given message isn't a poll update message")
+	ErrPollNotFound                  = errors.New("poll creation message not found in store")
 )
 
 type wrappedIQError struct {
@@ -163,7 +215,19 @@ func wrapIQError(human, iq error) error {
 	return &wrappedIQError{human, iq}
 }
 
-// IQError is a generic error container for info queries
+// IQError is a generic error container for info queries.
+//
+// All methods that send info queries return this error type (possibly wrapped) when the server responds
+// with an error. The sentinel errors below can be used with errors.Is to check for specific error codes,
+// while errors.As can be used to access the code, text and raw error node directly:
+//
+//	_, err := cli.GetGroupInfo(jid)
+//	var iqErr *whatsmeow.IQError
+//	if errors.Is(err, whatsmeow.ErrIQNotFound) {
+//		// the group doesn't exist
+//	} else if errors.As(err, &iqErr) {
+//		fmt.Println(iqErr.Code, iqErr.Text)
+//	}
 type IQError struct {
 	Code      int
 	Text      string
@@ -182,11 +246,29 @@ var (
 	ErrIQGone                error = &IQError{Code: 410, Text: "gone"}
 	ErrIQResourceLimit       error = &IQError{Code: 419, Text: "resource-limit"}
 	ErrIQLocked              error = &IQError{Code: 423, Text: "locked"}
+	ErrIQRateOverLimit       error = &IQError{Code: 429, Text: "rate-overlimit"}
 	ErrIQInternalServerError error = &IQError{Code: 500, Text: "internal-server-error"}
 	ErrIQServiceUnavailable  error = &IQError{Code: 503, Text: "service-unavailable"}
 	ErrIQPartialServerError  error = &IQError{Code: 530, Text: "partial-server-error"}
 )
 
+// RateLimitedError is returned by info queries if the server responds with a rate limit error (status code 429).
+//
+// It can be checked with errors.Is(err, ErrIQRateOverLimit), or with errors.As to get the suggested retry delay.
+type RateLimitedError struct {
+	*IQError
+	// How long to wait before retrying the request. If the server doesn't specify it, a default of 10 seconds is used.
+	RetryAfter time.Duration
+}
+
+func (err *RateLimitedError) Error() string {
+	return fmt.Sprintf("%s (retry after %s)", err.IQError.Error(), err.RetryAfter)
+}
+
+func (err *RateLimitedError) Unwrap() error {
+	return err.IQError
+}
+
 func parseIQError(node *waBinary.Node) error {
 	var err IQError
 	err.RawNode = node
@@ -196,6 +278,13 @@ func parseIQError(node *waBinary.Node) error {
 		ag := val.AttrGetter()
 		err.Code = ag.OptionalInt("code")
 		err.Text = ag.OptionalString("text")
+		if err.Code == 429 {
+			retryAfter := time.Duration(ag.OptionalInt("backoff")) * time.Second
+			if retryAfter <= 0 {
+				retryAfter = defaultRateLimitBackoff
+			}
+			return &RateLimitedError{IQError: &err, RetryAfter: retryAfter}
+		}
 	}
 	return &err
 }
@@ -236,6 +325,29 @@ func (eme *ElementMissingError) Error() string {
 	return fmt.Sprintf("missing <%s> element in %s", eme.Tag, eme.In)
 }
 
+// NoAckError is returned by SendMessage if the message was written to the websocket, but the server didn't
+// acknowledge it, either because of a timeout or because the connection was lost. The message may or may not have
+// been delivered, so apps should consider it pending rather than failed.
+//
+// Use errors.Is(err, ErrNoAck) to check for this error. Timeouts also match ErrMessageTimedOut.
+type NoAckError struct {
+	MessageID types.MessageID
+	// The underlying error, e.g. ErrMessageTimedOut or a *DisconnectedError.
+	Err error
+}
+
+func (err *NoAckError) Error() string {
+	return fmt.Sprintf("%v for %s: %v", ErrNoAck, err.MessageID, err.Err)
+}
+
+func (err *NoAckError) Is(other error) bool {
+	return other == ErrNoAck
+}
+
+func (err *NoAckError) Unwrap() error {
+	return err.Err
+}
+
 var ErrIQDisconnected = &DisconnectedError{Action: "info query"}
 
 // DisconnectedError is returned if the websocket disconnects before an info query or other request gets a response.
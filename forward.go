@@ -0,0 +1,42 @@
+// Copyright (c) 2022 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+)
+
+// BuildForward builds a copy of the given message that can be sent to another chat using Client.SendMessage.
+//
+// The message is marked as forwarded and the forwarding score is incremented, so that recipients
+// can see if the message has been forwarded many times (see events.Message.IsFrequentlyForwarded).
+// Reply and message secret metadata of the original message is removed.
+//
+// The message should be the unwrapped message from events.Message, not the raw message.
+// Plain text messages are converted to ExtendedTextMessage, as Conversation can't have a ContextInfo.
+func (cli *Client) BuildForward(msg *waProto.Message) *waProto.Message {
+	fwd := proto.Clone(msg).(*waProto.Message)
+	fwd.MessageContextInfo = nil
+	if fwd.Conversation != nil {
+		fwd.ExtendedTextMessage = &waProto.ExtendedTextMessage{Text: fwd.Conversation}
+		fwd.Conversation = nil
+	}
+	ctxInfo := types.GetMutableContextInfo(fwd)
+	if ctxInfo == nil {
+		return fwd
+	}
+	ctxInfo.IsForwarded = proto.Bool(true)
+	ctxInfo.ForwardingScore = proto.Uint32(ctxInfo.GetForwardingScore() + 1)
+	ctxInfo.StanzaId = nil
+	ctxInfo.Participant = nil
+	ctxInfo.RemoteJid = nil
+	ctxInfo.QuotedMessage = nil
+	return fwd
+}
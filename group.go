@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	waBinary "go.mau.fi/whatsmeow/binary"
 	"go.mau.fi/whatsmeow/types"
@@ -328,6 +329,25 @@ func (cli *Client) SetGroupLocked(jid types.JID, locked bool) error {
 	return err
 }
 
+// SetGroupJoinApprovalMode changes whether admins must approve new members before they can join the group.
+//
+// Pending requests can be fetched with GetGroupRequestParticipants and approved or rejected with UpdateGroupRequestParticipants.
+// New requests are emitted as *events.JoinApprovalRequest.
+func (cli *Client) SetGroupJoinApprovalMode(jid types.JID, requireApproval bool) error {
+	state := "on"
+	if !requireApproval {
+		state = "off"
+	}
+	_, err := cli.sendGroupIQ(context.TODO(), iqSet, jid, waBinary.Node{
+		Tag: "membership_approval_mode",
+		Content: []waBinary.Node{{
+			Tag:   "group_join",
+			Attrs: waBinary.Attrs{"state": state},
+		}},
+	})
+	return err
+}
+
 // SetGroupAnnounce changes whether the group is in announce mode (i.e. whether only admins can send messages).
 func (cli *Client) SetGroupAnnounce(jid types.JID, announce bool) error {
 	tag := "announcement"
@@ -479,6 +499,9 @@ func (cli *Client) GetJoinedGroups() ([]*types.GroupInfo, error) {
 }
 
 // GetSubGroups gets the subgroups of the given community.
+//
+// The community's announcement group (where only admins can send messages and all community members are
+// participants) has IsDefaultSubGroup set. The other groups are normal member groups linked to the community.
 func (cli *Client) GetSubGroups(community types.JID) ([]*types.GroupLinkTarget, error) {
 	res, err := cli.sendGroupIQ(context.TODO(), iqGet, community, waBinary.Node{Tag: "sub_groups"})
 	if err != nil {
@@ -501,6 +524,21 @@ func (cli *Client) GetSubGroups(community types.JID) ([]*types.GroupLinkTarget,
 	return parsedGroups, nil
 }
 
+// GetCommunityAnnouncementGroup gets the announcement group of the given community,
+// i.e. the subgroup that has IsDefaultSubGroup set. Returns nil if the community doesn't have one.
+func (cli *Client) GetCommunityAnnouncementGroup(community types.JID) (*types.GroupLinkTarget, error) {
+	subGroups, err := cli.GetSubGroups(community)
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range subGroups {
+		if group.IsDefaultSubGroup {
+			return group, nil
+		}
+	}
+	return nil, nil
+}
+
 // GetLinkedGroupsParticipants gets all the participants in the groups of the given community.
 func (cli *Client) GetLinkedGroupsParticipants(community types.JID) ([]types.JID, error) {
 	res, err := cli.sendGroupIQ(context.TODO(), iqGet, community, waBinary.Node{Tag: "linked_groups_participants"})
@@ -549,9 +587,43 @@ func (cli *Client) getGroupInfo(ctx context.Context, jid types.JID, lockParticip
 		participants[i] = part.JID
 	}
 	cli.groupParticipantsCache[jid] = participants
+	prevMode, hadMode := cli.groupAddressingModeCache[jid]
+	cli.groupAddressingModeCache[jid] = groupInfo.AddressingMode
+	if hadMode && prevMode != groupInfo.AddressingMode {
+		cli.Log.Debugf("Addressing mode of %s changed from %s to %s", jid, prevMode, groupInfo.AddressingMode)
+		go cli.dispatchEvent(&events.AddressingModeChanged{Chat: jid, Previous: prevMode, Mode: groupInfo.AddressingMode})
+	}
+	cli.cacheDisappearingTimer(jid, time.Duration(groupInfo.DisappearingTimer)*time.Second, time.Time{})
+	go cli.storeLIDMappings(groupInfo.Participants)
 	return groupInfo, nil
 }
 
+// getCachedGroupAddressingMode returns the addressing mode of the group from the cache filled by getGroupMembers.
+func (cli *Client) getCachedGroupAddressingMode(jid types.JID) types.AddressingMode {
+	cli.groupParticipantsCacheLock.Lock()
+	defer cli.groupParticipantsCacheLock.Unlock()
+	mode, ok := cli.groupAddressingModeCache[jid]
+	if !ok {
+		return types.AddressingModePN
+	}
+	return mode
+}
+
+func (cli *Client) storeLIDMappings(participants []types.GroupParticipant) {
+	if cli.Store.LIDs == nil {
+		return
+	}
+	for _, participant := range participants {
+		if participant.JID.Server != types.DefaultUserServer || participant.LID.IsEmpty() {
+			continue
+		}
+		err := cli.Store.LIDs.PutLIDMapping(participant.LID, participant.JID)
+		if err != nil {
+			cli.Log.Warnf("Failed to store LID mapping %s -> %s: %v", participant.LID, participant.JID, err)
+		}
+	}
+}
+
 func (cli *Client) getGroupMembers(ctx context.Context, jid types.JID) ([]types.JID, error) {
 	cli.groupParticipantsCacheLock.Lock()
 	defer cli.groupParticipantsCacheLock.Unlock()
@@ -606,6 +678,10 @@ func (cli *Client) parseGroupNode(groupNode *waBinary.Node) (*types.GroupInfo, e
 
 	group.AnnounceVersionID = ag.OptionalString("a_v_id")
 	group.ParticipantVersionID = ag.OptionalString("p_v_id")
+	group.AddressingMode = types.AddressingMode(ag.OptionalString("addressing_mode"))
+	if group.AddressingMode == "" {
+		group.AddressingMode = types.AddressingModePN
+	}
 
 	for _, child := range groupNode.GetChildren() {
 		childAG := child.AttrGetter()
@@ -640,7 +716,8 @@ func (cli *Client) parseGroupNode(groupNode *waBinary.Node) (*types.GroupInfo, e
 			group.DefaultMembershipApprovalMode = childAG.OptionalString("default_membership_approval_mode")
 		case "incognito":
 			group.IsIncognito = true
-		// TODO: membership_approval_mode
+		case "membership_approval_mode":
+			group.IsJoinApprovalRequired = parseMembershipApprovalMode(&child)
 		default:
 			cli.Log.Debugf("Unknown element in group node %s: %s", group.JID.String(), child.XMLString())
 		}
@@ -783,6 +860,10 @@ func (cli *Client) parseGroupChange(node *waBinary.Node) (*events.GroupInfo, err
 			}
 		case "not_ephemeral":
 			evt.Ephemeral = &types.GroupEphemeral{IsEphemeral: false}
+		case "membership_approval_mode":
+			evt.MembershipApprovalMode = &types.GroupMembershipApprovalMode{
+				IsJoinApprovalRequired: parseMembershipApprovalMode(&child),
+			}
 		case "link":
 			evt.Link = &types.GroupLinkChange{
 				Type: types.GroupLinkChangeType(cag.String("link_type")),
@@ -851,10 +932,62 @@ Outer:
 	cli.groupParticipantsCache[evt.JID] = cached
 }
 
+// handleSelfJoin dispatches a JoinedGroup event with the full group info if the given group change
+// added the current user to the group. Create notifications are already parsed as JoinedGroup events,
+// but some ways of joining (e.g. being added to a community subgroup) only send a normal add notification.
+func (cli *Client) handleSelfJoin(change *events.GroupInfo) {
+	ownID := cli.getOwnID().ToNonAD()
+	if ownID.IsEmpty() {
+		return
+	}
+	ownLID := cli.Store.LID.ToNonAD()
+	for _, jid := range change.Join {
+		jid = jid.ToNonAD()
+		if jid != ownID && (ownLID.IsEmpty() || jid != ownLID) {
+			continue
+		}
+		info, err := cli.GetGroupInfo(change.JID)
+		if err != nil {
+			cli.Log.Errorf("Failed to get info of %s after joining: %v", change.JID, err)
+			return
+		}
+		cli.dispatchEvent(&events.JoinedGroup{
+			Reason:    change.JoinReason,
+			GroupInfo: *info,
+		})
+		return
+	}
+}
+
+func parseMembershipApprovalMode(node *waBinary.Node) bool {
+	groupJoin, ok := node.GetOptionalChildByTag("group_join")
+	return ok && groupJoin.AttrGetter().OptionalString("state") == "on"
+}
+
+func parseJoinApprovalRequest(node *waBinary.Node) (*events.JoinApprovalRequest, error) {
+	ag := node.AttrGetter()
+	evt := &events.JoinApprovalRequest{
+		JID:       ag.JID("from"),
+		Timestamp: ag.UnixTime("t"),
+	}
+	child := node.GetChildren()[0]
+	evt.Revoked = child.Tag == "revoked_membership_requests"
+	evt.RequestMethod = child.AttrGetter().OptionalString("request_method")
+	evt.Participants = parseParticipantList(&child)
+	if len(evt.Participants) == 0 {
+		if requester := ag.OptionalJID("participant"); requester != nil {
+			evt.Participants = []types.JID{*requester}
+		}
+	}
+	return evt, ag.Error()
+}
+
 func (cli *Client) parseGroupNotification(node *waBinary.Node) (interface{}, error) {
 	children := node.GetChildren()
 	if len(children) == 1 && children[0].Tag == "create" {
 		return cli.parseGroupCreate(&children[0])
+	} else if len(children) == 1 && (children[0].Tag == "created_membership_requests" || children[0].Tag == "revoked_membership_requests") {
+		return parseJoinApprovalRequest(node)
 	} else {
 		groupChange, err := cli.parseGroupChange(node)
 		if err != nil {
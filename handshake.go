@@ -8,6 +8,7 @@ package whatsmeow
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"time"
 
@@ -25,7 +26,7 @@ const WACertIssuerSerial = 0
 var WACertPubKey = [...]byte{0x14, 0x23, 0x75, 0x57, 0x4d, 0xa, 0x58, 0x71, 0x66, 0xaa, 0xe7, 0x1e, 0xbe, 0x51, 0x64, 0x37, 0xc4, 0xa2, 0x8b, 0x73, 0xe3, 0x69, 0x5c, 0x6c, 0xe1, 0xf7, 0xf9, 0x54, 0x5d, 0xa8, 0xee, 0x6b}
 
 // doHandshake implements the Noise_XX_25519_AESGCM_SHA256 handshake for the WhatsApp web API.
-func (cli *Client) doHandshake(fs *socket.FrameSocket, ephemeralKP keys.KeyPair) error {
+func (cli *Client) doHandshake(ctx context.Context, fs *socket.FrameSocket, ephemeralKP keys.KeyPair) error {
 	nh := socket.NewNoiseHandshake()
 	nh.Start(socket.NoiseStartPattern, fs.Header)
 	nh.Authenticate(ephemeralKP.Pub[:])
@@ -46,6 +47,8 @@ func (cli *Client) doHandshake(fs *socket.FrameSocket, ephemeralKP keys.KeyPair)
 	case resp = <-fs.Frames:
 	case <-time.After(NoiseHandshakeResponseTimeout):
 		return fmt.Errorf("timed out waiting for handshake response")
+	case <-ctx.Done():
+		return ctx.Err()
 	}
 	var handshakeResponse waProto.HandshakeMessage
 	err = proto.Unmarshal(resp, &handshakeResponse)
@@ -80,7 +83,7 @@ func (cli *Client) doHandshake(fs *socket.FrameSocket, ephemeralKP keys.KeyPair)
 	certDecrypted, err := nh.Decrypt(certificateCiphertext)
 	if err != nil {
 		return fmt.Errorf("failed to decrypt noise certificate ciphertext: %w", err)
-	} else if err = verifyServerCert(certDecrypted, staticDecrypted); err != nil {
+	} else if err = verifyServerCert(certDecrypted, staticDecrypted, cli.getNoiseCertRootKey()); err != nil {
 		return fmt.Errorf("failed to verify server cert: %w", err)
 	}
 
@@ -95,6 +98,7 @@ func (cli *Client) doHandshake(fs *socket.FrameSocket, ephemeralKP keys.KeyPair)
 		clientPayload = cli.GetClientPayload()
 	} else {
 		clientPayload = cli.Store.GetClientPayload()
+		cli.applyDeviceProps(clientPayload)
 	}
 
 	clientFinishPayloadBytes, err := proto.Marshal(clientPayload)
@@ -126,7 +130,14 @@ func (cli *Client) doHandshake(fs *socket.FrameSocket, ephemeralKP keys.KeyPair)
 	return nil
 }
 
-func verifyServerCert(certDecrypted, staticDecrypted []byte) error {
+func (cli *Client) getNoiseCertRootKey() [32]byte {
+	if cli.NoiseCertRootKey != nil {
+		return *cli.NoiseCertRootKey
+	}
+	return WACertPubKey
+}
+
+func verifyServerCert(certDecrypted, staticDecrypted []byte, rootKey [32]byte) error {
 	var certChain waProto.CertChain
 	err := proto.Unmarshal(certDecrypted, &certChain)
 	if err != nil {
@@ -143,7 +154,7 @@ func verifyServerCert(certDecrypted, staticDecrypted []byte) error {
 		return fmt.Errorf("unexpected length of intermediate cert signature %d (expected 64)", len(intermediateCertSignature))
 	} else if len(leafCertSignature) != 64 {
 		return fmt.Errorf("unexpected length of leaf cert signature %d (expected 64)", len(leafCertSignature))
-	} else if !ecc.VerifySignature(ecc.NewDjbECPublicKey(WACertPubKey), intermediateCertDetailsRaw, [64]byte(intermediateCertSignature)) {
+	} else if !ecc.VerifySignature(ecc.NewDjbECPublicKey(rootKey), intermediateCertDetailsRaw, [64]byte(intermediateCertSignature)) {
 		return fmt.Errorf("failed to verify intermediate cert signature")
 	} else if err = proto.Unmarshal(intermediateCertDetailsRaw, &intermediateCertDetails); err != nil {
 		return fmt.Errorf("failed to unmarshal noise certificate details: %w", err)
@@ -54,6 +54,7 @@ func (cli *Client) keepAliveLoop(ctx context.Context) {
 					go cli.dispatchEvent(&events.KeepAliveRestored{})
 				}
 				lastSuccess = time.Now()
+				cli.checkPhoneOffline()
 			}
 		case <-ctx.Done():
 			return
@@ -0,0 +1,85 @@
+// Copyright (c) 2022 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"fmt"
+	"strconv"
+
+	"go.mau.fi/whatsmeow/appstate"
+	"go.mau.fi/whatsmeow/types"
+)
+
+// GetLabels returns the WhatsApp Business labels of the user.
+//
+// Labels are synced using app state, so this only contains the labels that have been received through
+// app state syncing (or created with CreateLabel). The labels of a specific chat are in Store.ChatSettings.GetChatSettings.
+func (cli *Client) GetLabels() ([]types.Label, error) {
+	if cli.Store.Labels == nil {
+		return nil, ErrNoLabelStore
+	}
+	return cli.Store.Labels.GetLabels()
+}
+
+// GetLabelChats returns the chats that have the given label applied.
+func (cli *Client) GetLabelChats(labelID string) ([]types.JID, error) {
+	if cli.Store.Labels == nil {
+		return nil, ErrNoLabelStore
+	}
+	return cli.Store.Labels.GetLabelChats(labelID)
+}
+
+// CreateLabel creates a new WhatsApp Business label with the given name and color.
+// The color is an index to the label color palette of the official apps (0-19).
+//
+// The new label is sent to the other devices using app state, and events.LabelEdit is dispatched for it.
+//
+// Label IDs are allocated locally, so the label app state collection is synced before picking the ID.
+// The first call on each client does a full resync, because labels that were created before the
+// label store existed are only received that way.
+func (cli *Client) CreateLabel(name string, color int32) (*types.Label, error) {
+	if cli.Store.Labels == nil {
+		return nil, ErrNoLabelStore
+	}
+	fullSync := !cli.labelsResynced.Load()
+	err := cli.FetchAppState(appstate.WAPatchRegular, fullSync, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sync labels: %w", err)
+	}
+	cli.labelsResynced.Store(true)
+	labels, err := cli.Store.Labels.GetLabels()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get existing labels: %w", err)
+	}
+	// Label IDs are sequential numbers
+	maxID := 0
+	for _, label := range labels {
+		if id, err := strconv.Atoi(label.ID); err == nil && id > maxID {
+			maxID = id
+		}
+	}
+	label := &types.Label{
+		ID:    strconv.Itoa(maxID + 1),
+		Name:  name,
+		Color: color,
+	}
+	err = cli.SendAppState(appstate.BuildLabelEdit(label.ID, label.Name, label.Color, false))
+	if err != nil {
+		return nil, err
+	}
+	return label, nil
+}
+
+// AddChatToLabel applies the given WhatsApp Business label to the given chat.
+func (cli *Client) AddChatToLabel(chat types.JID, labelID string) error {
+	return cli.SendAppState(appstate.BuildLabelChat(chat, labelID, true))
+}
+
+// RemoveChatFromLabel removes the given WhatsApp Business label from the given chat.
+func (cli *Client) RemoveChatFromLabel(chat types.JID, labelID string) error {
+	return cli.SendAppState(appstate.BuildLabelChat(chat, labelID, false))
+}
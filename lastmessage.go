@@ -0,0 +1,42 @@
+// Copyright (c) 2022 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+)
+
+func (cli *Client) storeLastMessage(info *types.MessageInfo) {
+	if !cli.TrackLastMessages || cli.Store.LastMessages == nil {
+		return
+	}
+	err := cli.Store.LastMessages.PutLastMessage(info.Chat, info.ID, info.Timestamp)
+	if err != nil {
+		cli.Log.Warnf("Failed to store %s as last message in %s: %v", info.ID, info.Chat, err)
+	}
+}
+
+// GetLastMessage returns the ID and timestamp of the newest message that has been received in the given chat,
+// or nil if no messages have been received in the chat. This requires Client.TrackLastMessages to be enabled.
+//
+// Comparing the timestamp with the messages in history syncs can be used to detect gaps,
+// which can then be filled using on-demand history sync requests (see BuildHistorySyncRequest).
+func (cli *Client) GetLastMessage(chat types.JID) (*store.LastMessage, error) {
+	if cli.Store.LastMessages == nil {
+		return nil, nil
+	}
+	return cli.Store.LastMessages.GetLastMessage(chat)
+}
+
+// GetAllLastMessages returns the newest received message in every chat. See GetLastMessage for more info.
+func (cli *Client) GetAllLastMessages() ([]store.LastMessage, error) {
+	if cli.Store.LastMessages == nil {
+		return nil, nil
+	}
+	return cli.Store.LastMessages.GetAllLastMessages()
+}
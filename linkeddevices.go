@@ -0,0 +1,89 @@
+// Copyright (c) 2022 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"context"
+	"math"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/types"
+)
+
+// GetLinkedDevices fetches the list of devices linked to the user's account from the server,
+// including the primary device (phone) and this device.
+//
+// Apps can use this to warn users about unexpected linked devices. Changes to the list are emitted
+// as events.LinkedDevicesChanged.
+func (cli *Client) GetLinkedDevices(ctx context.Context) ([]types.LinkedDevice, error) {
+	ownID := cli.getOwnID()
+	if ownID.IsEmpty() {
+		return nil, ErrNotLoggedIn
+	}
+	list, err := cli.usync(ctx, []types.JID{ownID.ToNonAD()}, "query", "message", []waBinary.Node{
+		{Tag: "devices", Attrs: waBinary.Attrs{"version": "2"}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var devices []types.LinkedDevice
+	for _, user := range list.GetChildren() {
+		jid, jidOK := user.Attrs["jid"].(types.JID)
+		if user.Tag != "user" || !jidOK || jid.User != ownID.User {
+			continue
+		}
+		deviceList := user.GetChildByTag("devices", "device-list")
+		for _, device := range deviceList.GetChildrenByTag("device") {
+			ag := device.AttrGetter()
+			deviceID, ok := ag.GetInt64("id", true)
+			if !ok || deviceID < 0 || deviceID > math.MaxUint16 {
+				continue
+			}
+			deviceJID := types.JID{User: jid.User, Server: types.DefaultUserServer, Device: uint16(deviceID)}
+			linkedDevice := types.LinkedDevice{
+				JID:          deviceJID,
+				IsPrimary:    deviceID == 0,
+				IsThisDevice: deviceJID.Device == ownID.Device,
+				KeyIndex:     uint32(ag.OptionalInt("key-index")),
+			}
+			if linkedDevice.IsPrimary {
+				linkedDevice.Platform = cli.Store.Platform
+				linkedDevice.LastActive = cli.LastPrimaryDeviceActivity()
+			}
+			devices = append(devices, linkedDevice)
+		}
+	}
+	deviceJIDs := make([]types.JID, len(devices))
+	for i, device := range devices {
+		deviceJIDs[i] = device.JID
+	}
+	cli.userDevicesCacheLock.Lock()
+	cli.userDevicesCache[ownID.ToNonAD()] = deviceCache{devices: deviceJIDs, dhash: participantListHashV2(deviceJIDs)}
+	cli.userDevicesCacheLock.Unlock()
+	return devices, nil
+}
+
+// diffDeviceLists returns the devices that are only in the new list and the devices that are only in the old list.
+func diffDeviceLists(oldDevices, newDevices []types.JID) (added, removed []types.JID) {
+	oldSet := make(map[types.JID]struct{}, len(oldDevices))
+	for _, device := range oldDevices {
+		oldSet[device] = struct{}{}
+	}
+	newSet := make(map[types.JID]struct{}, len(newDevices))
+	for _, device := range newDevices {
+		newSet[device] = struct{}{}
+		if _, ok := oldSet[device]; !ok {
+			added = append(added, device)
+		}
+	}
+	for _, device := range oldDevices {
+		if _, ok := newSet[device]; !ok {
+			removed = append(removed, device)
+		}
+	}
+	return
+}
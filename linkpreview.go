@@ -0,0 +1,179 @@
+// Copyright (c) 2022 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html"
+	"image"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+)
+
+// LinkPreview contains the metadata of a web page that is shown as a rich preview in text messages.
+type LinkPreview struct {
+	// The canonical URL of the page (og:url), if different from the URL in the message text.
+	CanonicalURL string
+	Title        string
+	Description  string
+	// The raw data of the preview image. It's converted into a small JPEG thumbnail before sending.
+	Image []byte
+}
+
+// LinkPreviewFetcher is a function that fetches the preview metadata for the given URL.
+//
+// Apps can set Client.LinkPreviewFetcher to a custom function, e.g. to cache previews or to use a different scraper.
+// The context has a timeout of Client.LinkPreviewTimeout.
+type LinkPreviewFetcher func(ctx context.Context, url string) (*LinkPreview, error)
+
+const (
+	defaultLinkPreviewTimeout = 5 * time.Second
+	// maxLinkPreviewPageSize is the maximum number of bytes read from a web page when looking for OpenGraph tags.
+	maxLinkPreviewPageSize = 512 * 1024
+	// maxLinkPreviewImageSize is the maximum size of the preview image that is downloaded.
+	maxLinkPreviewImageSize = 5 * 1024 * 1024
+)
+
+var (
+	linkPreviewURLRegex       = regexp.MustCompile(`https?://[^\s<>"]+[^\s<>".,:;!?)\]'}]`)
+	openGraphMetaRegex        = regexp.MustCompile(`(?i)<meta\s[^>]*?property=["']og:([a-z_:]+)["'][^>]*?content=["']([^"']*)["']`)
+	openGraphMetaReverseRegex = regexp.MustCompile(`(?i)<meta\s[^>]*?content=["']([^"']*)["'][^>]*?property=["']og:([a-z_:]+)["']`)
+	htmlTitleRegex            = regexp.MustCompile(`(?is)<title[^>]*>(.*?)</title>`)
+)
+
+func (cli *Client) getLinkPreviewTimeout() time.Duration {
+	if cli.LinkPreviewTimeout == 0 {
+		return defaultLinkPreviewTimeout
+	}
+	return cli.LinkPreviewTimeout
+}
+
+func (cli *Client) fetchLinkPreviewResource(ctx context.Context, targetURL string, maxSize int64) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, targetURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare request: %w", err)
+	}
+	// Some sites only include OpenGraph tags for known crawlers
+	req.Header.Set("User-Agent", "WhatsApp/2")
+	resp, err := cli.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxSize))
+}
+
+// FetchLinkPreview is the default LinkPreviewFetcher. It fetches the given web page and reads the
+// OpenGraph meta tags (og:title, og:description, og:url and og:image) from it.
+func (cli *Client) FetchLinkPreview(ctx context.Context, pageURL string) (*LinkPreview, error) {
+	page, err := cli.fetchLinkPreviewResource(ctx, pageURL, maxLinkPreviewPageSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch page: %w", err)
+	}
+	tags := make(map[string]string)
+	for _, match := range openGraphMetaRegex.FindAllSubmatch(page, -1) {
+		tags[string(match[1])] = html.UnescapeString(string(match[2]))
+	}
+	for _, match := range openGraphMetaReverseRegex.FindAllSubmatch(page, -1) {
+		tags[string(match[2])] = html.UnescapeString(string(match[1]))
+	}
+	preview := &LinkPreview{
+		CanonicalURL: tags["url"],
+		Title:        tags["title"],
+		Description:  tags["description"],
+	}
+	if preview.Title == "" {
+		if match := htmlTitleRegex.FindSubmatch(page); match != nil {
+			preview.Title = html.UnescapeString(string(bytes.TrimSpace(match[1])))
+		}
+	}
+	if imageURL := tags["image"]; imageURL != "" {
+		parsedPageURL, _ := url.Parse(pageURL)
+		parsedImageURL, err := url.Parse(imageURL)
+		if err == nil && parsedPageURL != nil {
+			preview.Image, err = cli.fetchLinkPreviewResource(ctx, parsedPageURL.ResolveReference(parsedImageURL).String(), maxLinkPreviewImageSize)
+		}
+		if err != nil {
+			cli.Log.Debugf("Failed to fetch link preview image for %s: %v", pageURL, err)
+		}
+	}
+	return preview, nil
+}
+
+// AddLinkPreview finds the first URL in the text of the given message and fills the link preview fields using
+// Client.LinkPreviewFetcher (or FetchLinkPreview if not set). If there are no URLs in the text, this does nothing.
+//
+// Fetching is limited by Client.LinkPreviewTimeout, so a slow web page won't delay sending the message for long.
+func (cli *Client) AddLinkPreview(ctx context.Context, msg *waProto.ExtendedTextMessage) error {
+	matchedURL := linkPreviewURLRegex.FindString(msg.GetText())
+	if matchedURL == "" {
+		return nil
+	}
+	fetcher := cli.LinkPreviewFetcher
+	if fetcher == nil {
+		fetcher = cli.FetchLinkPreview
+	}
+	ctx, cancel := context.WithTimeout(ctx, cli.getLinkPreviewTimeout())
+	defer cancel()
+	preview, err := fetcher(ctx, matchedURL)
+	if err != nil {
+		return err
+	} else if preview == nil {
+		return nil
+	}
+	msg.MatchedText = proto.String(matchedURL)
+	if preview.CanonicalURL != "" && preview.CanonicalURL != matchedURL {
+		msg.CanonicalUrl = proto.String(preview.CanonicalURL)
+	}
+	if preview.Title != "" {
+		msg.Title = proto.String(preview.Title)
+	}
+	if preview.Description != "" {
+		msg.Description = proto.String(preview.Description)
+	}
+	msg.PreviewType = waProto.ExtendedTextMessage_NONE.Enum()
+	if len(preview.Image) > 0 {
+		img, _, err := image.Decode(bytes.NewReader(preview.Image))
+		if err != nil {
+			cli.Log.Debugf("Failed to decode link preview image for %s: %v", matchedURL, err)
+		} else if thumbnail, _, _, err := GenerateThumbnail(img); err != nil {
+			cli.Log.Debugf("Failed to generate link preview thumbnail for %s: %v", matchedURL, err)
+		} else {
+			msg.JpegThumbnail = thumbnail
+		}
+	}
+	return nil
+}
+
+// SendText sends a plain text message.
+//
+// If withPreview is true, a link preview is generated for the first URL in the text using AddLinkPreview.
+// Failing to generate the preview is not fatal, the message is sent without a preview instead.
+func (cli *Client) SendText(ctx context.Context, to types.JID, text string, withPreview bool, extra ...SendRequestExtra) (SendResponse, error) {
+	if !withPreview {
+		return cli.SendMessage(ctx, to, &waProto.Message{Conversation: proto.String(text)}, extra...)
+	}
+	extendedText := &waProto.ExtendedTextMessage{Text: proto.String(text)}
+	err := cli.AddLinkPreview(ctx, extendedText)
+	if err != nil {
+		cli.Log.Warnf("Failed to generate link preview for message to %s: %v", to, err)
+	}
+	return cli.SendMessage(ctx, to, &waProto.Message{ExtendedTextMessage: extendedText}, extra...)
+}
@@ -873,13 +873,14 @@ case "senddoc":
 			log.Errorf("Failed to find preview thumbnail file generated by Tasker")
 			log.Infof("The video message will still be sent, but preview won't be available")
 			msg := &waProto.Message{VideoMessage: &waProto.VideoMessage{
-				Url:           proto.String(uploaded.URL),
-				DirectPath:    proto.String(uploaded.DirectPath),
-				MediaKey:      uploaded.MediaKey,
-				Mimetype:      proto.String(http.DetectContentType(data)),
-				FileEncSha256: uploaded.FileEncSHA256,
-				FileSha256:    uploaded.FileSHA256,
-				FileLength:    proto.Uint64(uint64(len(data))),
+				Url:              proto.String(uploaded.URL),
+				DirectPath:       proto.String(uploaded.DirectPath),
+				MediaKey:         uploaded.MediaKey,
+				Mimetype:         proto.String(http.DetectContentType(data)),
+				FileEncSha256:    uploaded.FileEncSHA256,
+				FileSha256:       uploaded.FileSHA256,
+				FileLength:       proto.Uint64(uint64(len(data))),
+				StreamingSidecar: uploaded.StreamingSidecar,
 			}}
 			resp, err := cli.SendMessage(context.Background(), recipient, msg)
 			if err != nil {
@@ -914,6 +915,7 @@ case "senddoc":
 				FileEncSha256: uploaded.FileEncSHA256,
 				FileSha256:    uploaded.FileSHA256,
 				FileLength:    proto.Uint64(uint64(len(data))),
+				StreamingSidecar: uploaded.StreamingSidecar,
 			}}
 			resp, err := cli.SendMessage(context.Background(), recipient, msg)
 			if err != nil {
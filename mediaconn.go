@@ -7,7 +7,9 @@
 package whatsmeow
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	waBinary "go.mau.fi/whatsmeow/binary"
@@ -40,15 +42,51 @@ func (mc *MediaConn) Expiry() time.Time {
 	return mc.FetchedAt.Add(time.Duration(mc.TTL) * time.Second)
 }
 
+// AuthExpiry returns the time when the upload auth token in the MediaConn expires.
+// This is usually earlier than Expiry. If the server didn't specify an auth TTL, this is the same as Expiry.
+func (mc *MediaConn) AuthExpiry() time.Time {
+	if mc.AuthTTL <= 0 {
+		return mc.Expiry()
+	}
+	return mc.FetchedAt.Add(time.Duration(mc.AuthTTL) * time.Second)
+}
+
+// GetMediaConn returns the cached media connection info (hosts and upload auth token), fetching it from the server
+// if it's not cached or has expired. The same cache is used by all uploads and downloads, so calling this doesn't
+// cause extra queries. The returned value must not be modified.
+func (cli *Client) GetMediaConn() (*MediaConn, error) {
+	return cli.refreshMediaConn(false)
+}
+
 func (cli *Client) refreshMediaConn(force bool) (*MediaConn, error) {
+	return cli.getMediaConn(force, nil)
+}
+
+// refreshFailedMediaConn refreshes the media connection after the given one was rejected by the media server.
+// If another request already replaced the failed connection, the new one is returned without querying again.
+func (cli *Client) refreshFailedMediaConn(failed *MediaConn) (*MediaConn, error) {
+	return cli.getMediaConn(true, failed)
+}
+
+func (cli *Client) getMediaConn(force bool, failed *MediaConn) (*MediaConn, error) {
 	cli.mediaConnLock.Lock()
 	defer cli.mediaConnLock.Unlock()
-	if cli.mediaConnCache == nil || force || time.Now().After(cli.mediaConnCache.Expiry()) {
+	if force && failed != nil && cli.mediaConnCache != nil && !cli.mediaConnCache.FetchedAt.Equal(failed.FetchedAt) {
+		force = false
+	}
+	now := time.Now()
+	if cli.mediaConnCache == nil || force || now.After(cli.mediaConnCache.Expiry()) || now.After(cli.mediaConnCache.AuthExpiry()) {
 		var err error
 		cli.mediaConnCache, err = cli.queryMediaConn()
 		if err != nil {
 			return nil, err
 		}
+		if len(cli.MediaHosts) > 0 {
+			cli.mediaConnCache.Hosts = make([]MediaConnHost, len(cli.MediaHosts))
+			for i, host := range cli.MediaHosts {
+				cli.mediaConnCache.Hosts[i] = MediaConnHost{Hostname: host}
+			}
+		}
 	}
 	return cli.mediaConnCache, nil
 }
@@ -91,3 +129,39 @@ func (cli *Client) queryMediaConn() (*MediaConn, error) {
 	}
 	return &mc, nil
 }
+
+// defaultMediaTransport returns the HTTP transport used for media transfers by default.
+//
+// There's no overall timeout, as downloading large files can take a long time (use the context parameter to
+// cancel transfers), but the server is expected to start responding within a reasonable time.
+func defaultMediaTransport() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.ResponseHeaderTimeout = 60 * time.Second
+	transport.MaxIdleConnsPerHost = 10
+	return transport
+}
+
+// SetMediaHTTPClient sets the HTTP client that is used for media uploads and downloads.
+//
+// If the given client uses a *http.Transport, the proxy set with SetProxy is not applied automatically.
+// Call SetProxy again after this if you want to use the same proxy for media.
+func (cli *Client) SetMediaHTTPClient(client *http.Client) {
+	cli.http = client
+}
+
+func (cli *Client) acquireMediaTransferSlot(ctx context.Context) (release func(), err error) {
+	cli.mediaTransferSlotsInit.Do(func() {
+		if cli.MaxConcurrentMediaTransfers > 0 {
+			cli.mediaTransferSlots = make(chan struct{}, cli.MaxConcurrentMediaTransfers)
+		}
+	})
+	if cli.mediaTransferSlots == nil {
+		return func() {}, nil
+	}
+	select {
+	case cli.mediaTransferSlots <- struct{}{}:
+		return func() { <-cli.mediaTransferSlots }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
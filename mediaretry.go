@@ -8,6 +8,7 @@ package whatsmeow
 
 import (
 	"fmt"
+	"time"
 
 	"go.mau.fi/util/random"
 	"google.golang.org/protobuf/proto"
@@ -20,6 +21,37 @@ import (
 	"go.mau.fi/whatsmeow/util/hkdfutil"
 )
 
+// mediaRetryKeyTTL is how long the media key is remembered after sending a media retry receipt.
+const mediaRetryKeyTTL = 1 * time.Hour
+
+type pendingMediaRetry struct {
+	MediaKey []byte
+	SentAt   time.Time
+}
+
+func (cli *Client) storeMediaRetryKey(id types.MessageID, mediaKey []byte) {
+	cli.mediaRetryKeysLock.Lock()
+	defer cli.mediaRetryKeysLock.Unlock()
+	now := time.Now()
+	for key, pending := range cli.mediaRetryKeys {
+		if now.Sub(pending.SentAt) > mediaRetryKeyTTL {
+			delete(cli.mediaRetryKeys, key)
+		}
+	}
+	cli.mediaRetryKeys[id] = pendingMediaRetry{MediaKey: mediaKey, SentAt: now}
+}
+
+func (cli *Client) popMediaRetryKey(id types.MessageID) []byte {
+	cli.mediaRetryKeysLock.Lock()
+	defer cli.mediaRetryKeysLock.Unlock()
+	pending, ok := cli.mediaRetryKeys[id]
+	if !ok {
+		return nil
+	}
+	delete(cli.mediaRetryKeys, id)
+	return pending.MediaKey
+}
+
 func getMediaRetryKey(mediaKey []byte) (cipherKey []byte) {
 	return hkdfutil.SHA256(mediaKey, nil, []byte("WhatsApp Media Retry Notification"), 32)
 }
@@ -55,20 +87,21 @@ func encryptMediaRetryReceipt(messageID types.MessageID, mediaKey []byte) (ciphe
 //	  mediaRetryCache[evt.Info.ID] = imageMsg
 //	}
 //
-// The response will come as an *events.MediaRetry. The response will then have to be decrypted
-// using DecryptMediaRetryNotification and the same media key passed here. If the media retry was successful,
-// the decrypted notification should contain an updated DirectPath, which can be used to download the file.
+// The response will come as an *events.MediaRetry. The media key passed here is remembered for an hour, so if the
+// response arrives while the same client is running, it's decrypted automatically and the updated DirectPath is in
+// the event. Otherwise, the response has to be decrypted using DecryptMediaRetryNotification and the same media key.
+// If the media retry was successful, the decrypted notification contains an updated DirectPath, which can be used
+// to download the file.
 //
 //	func eventHandler(rawEvt interface{}) {
 //	  switch evt := rawEvt.(type) {
 //	  case *events.MediaRetry:
 //	    imageMsg := mediaRetryCache[evt.MessageID]
-//	    retryData, err := whatsmeow.DecryptMediaRetryNotification(evt, imageMsg.GetMediaKey())
-//	    if err != nil || retryData.GetResult != waProto.MediaRetryNotification_SUCCESS {
+//	    if evt.DirectPath == "" {
 //	      return
 //	    }
 //	    // Use the new path to download the attachment
-//	    imageMsg.DirectPath = retryData.DirectPath
+//	    imageMsg.DirectPath = proto.String(evt.DirectPath)
 //	    data, err := cli.Download(imageMsg)
 //	    // Alternatively, you can use cli.DownloadMediaWithPath and provide the individual fields manually.
 //	  }
@@ -111,6 +144,7 @@ func (cli *Client) SendMediaRetryReceipt(message *types.MessageInfo, mediaKey []
 	if err != nil {
 		return err
 	}
+	cli.storeMediaRetryKey(message.ID, mediaKey)
 	return nil
 }
 
@@ -177,5 +211,13 @@ func (cli *Client) handleMediaRetryNotification(node *waBinary.Node) {
 		cli.Log.Warnf("Failed to parse media retry notification: %v", err)
 		return
 	}
+	if mediaKey := cli.popMediaRetryKey(evt.MessageID); mediaKey != nil {
+		evt.Result, err = DecryptMediaRetryNotification(evt, mediaKey)
+		if err != nil {
+			cli.Log.Warnf("Failed to decrypt media retry notification for %s: %v", evt.MessageID, err)
+		} else if evt.Result.GetResult() == waProto.MediaRetryNotification_SUCCESS {
+			evt.DirectPath = evt.Result.GetDirectPath()
+		}
+	}
 	cli.dispatchEvent(evt)
 }
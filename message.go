@@ -13,7 +13,9 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"runtime"
 	"runtime/debug"
+	"sync"
 	"time"
 
 	"go.mau.fi/libsignal/groups"
@@ -34,6 +36,10 @@ import (
 var pbSerializer = store.SignalProtobufSerializer
 
 func (cli *Client) handleEncryptedMessage(node *waBinary.Node) {
+	// Offline messages are counted before parsing, so that unparseable stanzas aren't reported as dropped
+	if offline, _ := node.Attrs["offline"].(string); offline != "" {
+		cli.offlineReceivedMessages.Add(1)
+	}
 	info, err := cli.parseMessageInfo(node)
 	if err != nil {
 		cli.Log.Warnf("Failed to parse message: %v", err)
@@ -44,7 +50,7 @@ func (cli *Client) handleEncryptedMessage(node *waBinary.Node) {
 		if len(info.PushName) > 0 && info.PushName != "-" {
 			go cli.updatePushName(info.Sender, info, info.PushName)
 		}
-		go cli.sendAck(node)
+		cli.goTracked(func() { cli.sendAck(node) })
 		if info.Sender.Server == types.NewsletterServer {
 			cli.handlePlaintextMessage(info, node)
 		} else {
@@ -71,10 +77,25 @@ func (cli *Client) parseMessageSource(node *waBinary.Node, requireParticipant bo
 		}
 		if source.Sender.User == clientID.User {
 			source.IsFromMe = true
+		} else if source.Sender.Server == types.HiddenUserServer {
+			if ownLID := cli.Store.LID; !ownLID.IsEmpty() && source.Sender.User == ownLID.User {
+				source.IsFromMe = true
+			}
+			if senderPN := ag.OptionalJIDOrEmpty("participant_pn"); !senderPN.IsEmpty() && cli.Store.LIDs != nil {
+				go cli.storeLIDMappings([]types.GroupParticipant{{JID: senderPN.ToNonAD(), LID: source.Sender.ToNonAD()}})
+			}
 		}
 		if from.Server == types.BroadcastServer {
 			source.BroadcastListOwner = ag.OptionalJIDOrEmpty("recipient")
 		}
+		source.AddressingMode = types.AddressingMode(ag.OptionalString("addressing_mode"))
+		if source.AddressingMode == "" && !source.Sender.IsEmpty() {
+			if source.Sender.Server == types.HiddenUserServer {
+				source.AddressingMode = types.AddressingModeLID
+			} else {
+				source.AddressingMode = types.AddressingModePN
+			}
+		}
 	} else if from.Server == types.NewsletterServer {
 		source.Chat = from
 		source.Sender = from
@@ -111,6 +132,7 @@ func (cli *Client) parseMessageInfo(node *waBinary.Node) (*types.MessageInfo, er
 	info.Category = ag.OptionalString("category")
 	info.Type = ag.OptionalString("type")
 	info.Edit = types.EditAttribute(ag.OptionalString("edit"))
+	info.IsOffline = ag.OptionalString("offline") != ""
 	if !ag.OK() {
 		return nil, ag.Error()
 	}
@@ -175,7 +197,7 @@ func (cli *Client) handlePlaintextMessage(info *types.MessageInfo, node *waBinar
 func (cli *Client) decryptMessages(info *types.MessageInfo, node *waBinary.Node) {
 	if len(node.GetChildrenByTag("unavailable")) > 0 && len(node.GetChildrenByTag("enc")) == 0 {
 		cli.Log.Warnf("Unavailable message %s from %s", info.ID, info.SourceString())
-		go cli.sendRetryReceipt(node, info, true)
+		cli.goTracked(func() { cli.sendRetryReceipt(node, info, true) })
 		cli.dispatchEvent(&events.UndecryptableMessage{Info: *info, IsUnavailable: true})
 		return
 	}
@@ -184,6 +206,7 @@ func (cli *Client) decryptMessages(info *types.MessageInfo, node *waBinary.Node)
 	cli.Log.Debugf("Decrypting message from %s", info.SourceString())
 	handled := false
 	containsDirectMsg := false
+	var verifiedSender, verificationChecked bool
 	for _, child := range children {
 		if child.Tag != "enc" {
 			continue
@@ -207,7 +230,7 @@ func (cli *Client) decryptMessages(info *types.MessageInfo, node *waBinary.Node)
 		if err != nil {
 			cli.Log.Warnf("Error decrypting message from %s: %v", info.SourceString(), err)
 			isUnavailable := encType == "skmsg" && !containsDirectMsg && errors.Is(err, signalerror.ErrNoSenderKeyForUser)
-			go cli.sendRetryReceipt(node, info, isUnavailable)
+			cli.goTracked(func() { cli.sendRetryReceipt(node, info, isUnavailable) })
 			cli.dispatchEvent(&events.UndecryptableMessage{
 				Info:            *info,
 				IsUnavailable:   isUnavailable,
@@ -219,6 +242,11 @@ func (cli *Client) decryptMessages(info *types.MessageInfo, node *waBinary.Node)
 		if retryCount > 0 {
 			cli.cancelDelayedRequestFromPhone(info.ID)
 		}
+		if cli.CheckSenderVerification && !verificationChecked {
+			// This is checked after decrypting, as a prekey message may have replaced the identity key
+			verifiedSender = cli.isSenderVerified(info.Sender)
+			verificationChecked = true
+		}
 
 		var msg waProto.Message
 		switch ag.Int("v") {
@@ -228,7 +256,7 @@ func (cli *Client) decryptMessages(info *types.MessageInfo, node *waBinary.Node)
 				cli.Log.Warnf("Error unmarshaling decrypted message from %s: %v", info.SourceString(), err)
 				continue
 			}
-			cli.handleDecryptedMessage(info, &msg, retryCount)
+			cli.handleDecryptedMessage(info, &msg, retryCount, verifiedSender)
 			handled = true
 		case 3:
 			handled = cli.handleDecryptedArmadillo(info, decrypted, retryCount)
@@ -236,21 +264,104 @@ func (cli *Client) decryptMessages(info *types.MessageInfo, node *waBinary.Node)
 			cli.Log.Warnf("Unknown version %d in decrypted message from %s", ag.Int("v"), info.SourceString())
 		}
 	}
-	if handled {
-		go cli.sendMessageReceipt(info)
+	if handled && !cli.DisableDeliveryReceipts {
+		cli.goTracked(func() { cli.sendMessageReceipt(info) })
 	}
 }
 
-func (cli *Client) clearUntrustedIdentity(target types.JID) {
-	err := cli.Store.Identities.DeleteIdentity(target.SignalAddress().String())
+// handleUntrustedIdentity is called when processing a message or prekey bundle fails because the identity key is not trusted.
+// It returns true if the identity was cleared and the operation should be retried.
+func (cli *Client) handleUntrustedIdentity(target types.JID, key [32]byte) bool {
+	existing, err := cli.Store.Identities.GetIdentity(target.SignalAddressString())
 	if err != nil {
-		cli.Log.Warnf("Failed to delete untrusted identity of %s from store: %v", target, err)
+		cli.Log.Warnf("Failed to get stored identity of %s: %v", target, err)
+		return false
+	} else if existing == nil {
+		cli.Log.Warnf("Identity of %s is not known and unknown identities are not trusted", target)
+		cli.dispatchEvent(&events.UnknownIdentity{JID: target, IdentityKey: key, Timestamp: time.Now()})
+		return false
+	} else if !cli.AutoTrustIdentity {
+		cli.Log.Warnf("Identity of %s has changed, but automatic trust is disabled", target)
+		cli.dispatchEvent(&events.IdentityChange{
+			JID:            target,
+			Timestamp:      time.Now(),
+			Implicit:       true,
+			OldIdentityKey: existing,
+			NewIdentityKey: key[:],
+		})
+		return false
+	}
+	cli.Log.Warnf("Identity of %s has changed, replacing stored identity and retrying", target)
+	return cli.replaceUntrustedIdentity(target, existing, key)
+}
+
+// TrustIdentity stores the given identity key for the given device, which means messages will be encrypted and
+// decrypted with it without errors. This is mostly useful when unknown identities are rejected by the store
+// (see events.UnknownIdentity).
+func (cli *Client) TrustIdentity(jid types.JID, key [32]byte) error {
+	return cli.Store.Identities.PutIdentity(jid.SignalAddressString(), key)
+}
+
+// SetIdentityVerified marks the identity key of the given device as verified, or removes the mark. Keys should only be
+// marked as verified after comparing security codes with the other user out of band. Verifying a key also trusts it
+// like TrustIdentity. If Client.CheckSenderVerification is enabled, messages from devices with verified keys
+// have events.Message.VerifiedSender set.
+//
+// The mark is bound to the key, so if the identity of the device changes, its messages are no longer verified.
+func (cli *Client) SetIdentityVerified(jid types.JID, key [32]byte, verified bool) error {
+	if cli.Store.VerifiedIdentities == nil {
+		return ErrNoVerifiedIdentityStore
 	}
-	err = cli.Store.Sessions.DeleteSession(target.SignalAddress().String())
+	if verified {
+		err := cli.TrustIdentity(jid, key)
+		if err != nil {
+			return fmt.Errorf("failed to store identity: %w", err)
+		}
+	}
+	return cli.Store.VerifiedIdentities.PutVerifiedIdentity(jid.SignalAddressString(), key, verified)
+}
+
+// isSenderVerified returns true if the currently stored identity key of the given device has been marked as verified.
+func (cli *Client) isSenderVerified(sender types.JID) bool {
+	if cli.Store.VerifiedIdentities == nil {
+		return false
+	}
+	address := sender.SignalAddressString()
+	identity, err := cli.Store.Identities.GetIdentity(address)
+	if err != nil {
+		cli.Log.Warnf("Failed to get identity of %s to check verification: %v", sender, err)
+		return false
+	} else if len(identity) != 32 {
+		return false
+	}
+	verified, err := cli.Store.VerifiedIdentities.IsIdentityVerified(address, [32]byte(identity))
+	if err != nil {
+		cli.Log.Warnf("Failed to check if identity of %s is verified: %v", sender, err)
+		return false
+	}
+	return verified
+}
+
+// replaceUntrustedIdentity stores the new identity key of the device in place of the old one and deletes the session
+// that was established with the old key. The new key is stored directly instead of deleting the old one, so that
+// the retry works even if the store rejects unknown identities. It returns true if the new key was stored.
+func (cli *Client) replaceUntrustedIdentity(target types.JID, oldKey []byte, newKey [32]byte) bool {
+	err := cli.Store.Identities.PutIdentity(target.SignalAddressString(), newKey)
+	accepted := err == nil
 	if err != nil {
+		cli.Log.Warnf("Failed to store new identity of %s: %v", target, err)
+	} else if err = cli.Store.Sessions.DeleteSession(target.SignalAddressString()); err != nil {
 		cli.Log.Warnf("Failed to delete session with %s (untrusted identity) from store: %v", target, err)
 	}
-	cli.dispatchEvent(&events.IdentityChange{JID: target, Timestamp: time.Now(), Implicit: true})
+	cli.dispatchEvent(&events.IdentityChange{
+		JID:            target,
+		Timestamp:      time.Now(),
+		Implicit:       true,
+		AutoAccepted:   accepted,
+		OldIdentityKey: oldKey,
+		NewIdentityKey: newKey[:],
+	})
+	return accepted
 }
 
 func (cli *Client) decryptDM(child *waBinary.Node, from types.JID, isPreKey bool) ([]byte, error) {
@@ -265,9 +376,7 @@ func (cli *Client) decryptDM(child *waBinary.Node, from types.JID, isPreKey bool
 			return nil, fmt.Errorf("failed to parse prekey message: %w", err)
 		}
 		plaintext, _, err = cipher.DecryptMessageReturnKey(preKeyMsg)
-		if cli.AutoTrustIdentity && errors.Is(err, signalerror.ErrUntrustedIdentity) {
-			cli.Log.Warnf("Got %v error while trying to decrypt prekey message from %s, clearing stored identity and retrying", err, from)
-			cli.clearUntrustedIdentity(from)
+		if errors.Is(err, signalerror.ErrUntrustedIdentity) && cli.handleUntrustedIdentity(from, preKeyMsg.IdentityKey().PublicKey().PublicKey()) {
 			plaintext, _, err = cipher.DecryptMessageReturnKey(preKeyMsg)
 		}
 		if err != nil {
@@ -364,12 +473,64 @@ func (cli *Client) handleHistorySyncNotificationLoop() {
 			go cli.handleHistorySyncNotificationLoop()
 		}
 	}()
+	// Blobs are downloaded and decoded in parallel, but each worker waits for the previous one
+	// before dispatching, so that HistorySync events are still emitted in the order they were received.
+	slots := make(chan struct{}, cli.getHistorySyncConcurrency())
+	memLimit := cli.HistorySyncMemoryLimit
+	if memLimit <= 0 {
+		memLimit = defaultHistorySyncMemoryLimit
+	}
+	var inFlightBytes int64
+	var inFlightLock sync.Mutex
+	inFlightCond := sync.NewCond(&inFlightLock)
+	var prevDispatched chan struct{}
 	for notif := range cli.historySyncNotifications {
-		cli.handleHistorySyncNotification(notif)
+		slots <- struct{}{}
+		size := int64(notif.GetFileLength())
+		inFlightLock.Lock()
+		// Workers release their memory in order, so waiting here can't deadlock: the oldest worker never waits for anything.
+		for inFlightBytes > 0 && inFlightBytes+size > memLimit {
+			inFlightCond.Wait()
+		}
+		inFlightBytes += size
+		inFlightLock.Unlock()
+		waitFor := prevDispatched
+		dispatched := make(chan struct{})
+		prevDispatched = dispatched
+		go func(notif *waProto.HistorySyncNotification) {
+			defer func() {
+				err := recover()
+				if err != nil {
+					cli.Log.Errorf("History sync worker panicked: %v\n%s", err, debug.Stack())
+				}
+				close(dispatched)
+				inFlightLock.Lock()
+				inFlightBytes -= size
+				inFlightCond.Broadcast()
+				inFlightLock.Unlock()
+				<-slots
+			}()
+			historySync := cli.decodeHistorySyncNotification(notif)
+			if waitFor != nil {
+				<-waitFor
+			}
+			if historySync != nil {
+				cli.handleHistorySync(historySync)
+			}
+		}(notif)
 	}
 }
 
-func (cli *Client) handleHistorySyncNotification(notif *waProto.HistorySyncNotification) {
+const defaultHistorySyncMemoryLimit = 64 * 1024 * 1024
+
+func (cli *Client) getHistorySyncConcurrency() int {
+	if cli.HistorySyncConcurrency <= 0 {
+		return runtime.GOMAXPROCS(0)
+	}
+	return cli.HistorySyncConcurrency
+}
+
+func (cli *Client) decodeHistorySyncNotification(notif *waProto.HistorySyncNotification) *waProto.HistorySync {
 	var historySync waProto.HistorySync
 	if data, err := cli.Download(notif); err != nil {
 		cli.Log.Errorf("Failed to download history sync data: %v", err)
@@ -380,22 +541,28 @@ func (cli *Client) handleHistorySyncNotification(notif *waProto.HistorySyncNotif
 	} else if err = proto.Unmarshal(rawData, &historySync); err != nil {
 		cli.Log.Errorf("Failed to unmarshal history sync data: %v", err)
 	} else {
-		cli.Log.Debugf("Received history sync (type %s, chunk %d)", historySync.GetSyncType(), historySync.GetChunkOrder())
-		if historySync.GetSyncType() == waProto.HistorySync_PUSH_NAME {
-			go cli.handleHistoricalPushNames(historySync.GetPushnames())
-		} else if len(historySync.GetConversations()) > 0 {
-			go cli.storeHistoricalMessageSecrets(historySync.GetConversations())
-		}
-		cli.dispatchEvent(&events.HistorySync{
-			Data: &historySync,
-		})
+		return &historySync
+	}
+	return nil
+}
+
+func (cli *Client) handleHistorySync(historySync *waProto.HistorySync) {
+	cli.Log.Debugf("Received history sync (type %s, chunk %d)", historySync.GetSyncType(), historySync.GetChunkOrder())
+	if historySync.GetSyncType() == waProto.HistorySync_PUSH_NAME {
+		go cli.handleHistoricalPushNames(historySync.GetPushnames())
+	} else if len(historySync.GetConversations()) > 0 {
+		go cli.storeHistoricalMessageSecrets(historySync.GetConversations())
 	}
+	cli.dispatchEvent(&events.HistorySync{
+		Data: historySync,
+	})
 }
 
 func (cli *Client) handleAppStateSyncKeyShare(keys *waProto.AppStateSyncKeyShare) {
 	onlyResyncIfNotSynced := true
 
 	cli.Log.Debugf("Got %d new app state keys", len(keys.GetKeys()))
+	receivedEvts := make([]*events.AppStateSyncKeyReceived, 0, len(keys.GetKeys()))
 	cli.appStateKeyRequestsLock.RLock()
 	for _, key := range keys.GetKeys() {
 		marshaledFingerprint, err := proto.Marshal(key.GetKeyData().GetFingerprint())
@@ -417,8 +584,16 @@ func (cli *Client) handleAppStateSyncKeyShare(keys *waProto.AppStateSyncKeyShare
 			continue
 		}
 		cli.Log.Debugf("Received app state sync key %X (ts: %d)", key.GetKeyId().GetKeyId(), key.GetKeyData().GetTimestamp())
+		receivedEvts = append(receivedEvts, &events.AppStateSyncKeyReceived{
+			KeyID:        key.GetKeyId().GetKeyId(),
+			Timestamp:    time.UnixMilli(key.GetKeyData().GetTimestamp()),
+			WasRequested: isReRequest,
+		})
 	}
 	cli.appStateKeyRequestsLock.RUnlock()
+	for _, evt := range receivedEvts {
+		cli.dispatchEvent(evt)
+	}
 
 	for _, name := range appstate.AllPatchNames {
 		err := cli.FetchAppState(name, false, onlyResyncIfNotSynced)
@@ -455,7 +630,7 @@ func (cli *Client) handleProtocolMessage(info *types.MessageInfo, msg *waProto.M
 		if cli.historySyncHandlerStarted.CompareAndSwap(false, true) {
 			go cli.handleHistorySyncNotificationLoop()
 		}
-		go cli.sendProtocolMessageReceipt(info.ID, types.ReceiptTypeHistorySync)
+		cli.goTracked(func() { cli.sendProtocolMessageReceipt(info.ID, types.ReceiptTypeHistorySync) })
 	}
 
 	if protoMsg.GetPeerDataOperationRequestResponseMessage().GetPeerDataOperationRequestType() == waProto.PeerDataOperationRequestType_PLACEHOLDER_MESSAGE_RESEND {
@@ -466,8 +641,18 @@ func (cli *Client) handleProtocolMessage(info *types.MessageInfo, msg *waProto.M
 		go cli.handleAppStateSyncKeyShare(protoMsg.AppStateSyncKeyShare)
 	}
 
+	if protoMsg.GetType() == waProto.ProtocolMessage_EPHEMERAL_SETTING && !info.IsGroup {
+		cli.cacheDisappearingTimer(info.Chat, time.Duration(protoMsg.GetEphemeralExpiration())*time.Second, info.Timestamp)
+		go cli.dispatchEvent(&events.DisappearingTimerChanged{
+			Chat:      info.Chat,
+			Sender:    info.Sender,
+			Timer:     time.Duration(protoMsg.GetEphemeralExpiration()) * time.Second,
+			Timestamp: info.Timestamp,
+		})
+	}
+
 	if info.Category == "peer" {
-		go cli.sendProtocolMessageReceipt(info.ID, types.ReceiptTypePeerMsg)
+		cli.goTracked(func() { cli.sendProtocolMessageReceipt(info.ID, types.ReceiptTypePeerMsg) })
 	}
 }
 
@@ -570,10 +755,46 @@ func (cli *Client) storeHistoricalMessageSecrets(conversations []*waProto.Conver
 	}
 }
 
-func (cli *Client) handleDecryptedMessage(info *types.MessageInfo, msg *waProto.Message, retryCount int) {
+func (cli *Client) handleDecryptedMessage(info *types.MessageInfo, msg *waProto.Message, retryCount int, verifiedSender bool) {
 	cli.processProtocolParts(info, msg)
-	evt := &events.Message{Info: *info, RawMessage: msg, RetryCount: retryCount}
-	cli.dispatchEvent(evt.UnwrapRaw())
+	if cli.IgnoreBlockedMessages && !info.IsFromMe && cli.isBlocked(info.Sender) {
+		cli.Log.Debugf("Dropping message %s from blocked user %s", info.ID, info.Sender)
+		return
+	}
+	if info.IsFromMe && info.Sender.Device == 0 {
+		cli.markPrimaryDeviceActivity(info.Timestamp)
+	}
+	evt := &events.Message{Info: *info, RawMessage: msg, RetryCount: retryCount, VerifiedSender: verifiedSender}
+	evt.UnwrapRaw()
+	evt.Info.ClientTimestamp = getClientTimestamp(evt.Message)
+	evt.Info.AlbumID = getAlbumID(msg, evt.Message)
+	if cli.CheckChatMuted && !info.IsFromMe {
+		evt.IsMuted, _, _ = cli.IsChatMuted(info.Chat)
+	}
+	cli.storePollMessage(evt)
+	cli.storeLastMessage(info)
+	cli.dispatchEvent(evt)
+}
+
+// getClientTimestamp returns the sender's device timestamp from messages whose protobuf includes one.
+func getClientTimestamp(msg *waProto.Message) time.Time {
+	var millis int64
+	switch {
+	case msg.ReactionMessage != nil:
+		millis = msg.GetReactionMessage().GetSenderTimestampMs()
+	case msg.PollUpdateMessage != nil:
+		millis = msg.GetPollUpdateMessage().GetSenderTimestampMs()
+	case msg.ProtocolMessage != nil:
+		millis = msg.GetProtocolMessage().GetTimestampMs()
+	case msg.PinInChatMessage != nil:
+		millis = msg.GetPinInChatMessage().GetSenderTimestampMs()
+	case msg.KeepInChatMessage != nil:
+		millis = msg.GetKeepInChatMessage().GetTimestampMs()
+	}
+	if millis == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(millis)
 }
 
 func (cli *Client) sendProtocolMessageReceipt(id types.MessageID, msgType types.ReceiptType) {
@@ -0,0 +1,60 @@
+// Copyright (c) 2022 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// ParseMessageKey converts a MessageKey referenced inside a message (e.g. the target of a reaction, revocation,
+// edit or poll vote) into the canonical types.MessageKey of the original message.
+//
+// The FromMe and RemoteJID fields in the protobuf key are relative to the sender of the referencing message,
+// so they can't be used as-is for looking up messages. The returned key has the same format as
+// types.MessageInfo.Key(), which means it can be compared directly with keys of received messages.
+func (cli *Client) ParseMessageKey(msg *events.Message, key *waProto.MessageKey) (types.MessageKey, error) {
+	sender, err := getOrigSenderFromKey(msg, key)
+	if err != nil {
+		return types.MessageKey{}, err
+	}
+	sender = sender.ToNonAD()
+	return types.MessageKey{
+		Chat:     msg.Info.Chat,
+		Sender:   sender,
+		ID:       key.GetId(),
+		IsFromMe: sender.User == cli.getOwnID().User,
+	}, nil
+}
+
+// ParseContextInfoKey returns the canonical key of the message that the given message is replying to.
+// If the message isn't a reply, the second return value is false.
+func (cli *Client) ParseContextInfoKey(msg *events.Message, ctxInfo *waProto.ContextInfo) (types.MessageKey, bool, error) {
+	if ctxInfo.GetStanzaId() == "" {
+		return types.MessageKey{}, false, nil
+	}
+	sender, err := types.ParseJID(ctxInfo.GetParticipant())
+	if err != nil {
+		return types.MessageKey{}, false, err
+	} else if sender.IsEmpty() {
+		sender = msg.Info.Chat
+	}
+	sender = sender.ToNonAD()
+	return types.MessageKey{
+		Chat:     msg.Info.Chat,
+		Sender:   sender,
+		ID:       ctxInfo.GetStanzaId(),
+		IsFromMe: sender.User == cli.getOwnID().User,
+	}, true, nil
+}
+
+// BuildMessageKeyFrom builds a protobuf MessageKey referencing the message with the given canonical key.
+// This is equivalent to BuildMessageKey(key.Chat, key.Sender, key.ID).
+func (cli *Client) BuildMessageKeyFrom(key types.MessageKey) *waProto.MessageKey {
+	return cli.BuildMessageKey(key.Chat, key.Sender, key.ID)
+}
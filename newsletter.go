@@ -8,7 +8,9 @@ package whatsmeow
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -75,6 +77,9 @@ func (cli *Client) NewsletterMarkViewed(jid types.JID, serverIDs []types.Message
 // NewsletterSendReaction sends a reaction to a channel message.
 // To remove a reaction sent earlier, set reaction to an empty string.
 //
+// Unlike normal chats, reactions in channels refer to the server ID of the message rather than the message ID.
+// To edit or delete channel posts, use BuildEdit or BuildRevoke with Client.SendMessage.
+//
 // The last parameter is the message ID of the reaction itself. It can be left empty to let whatsmeow generate a random one.
 func (cli *Client) NewsletterSendReaction(jid types.JID, serverID types.MessageServerID, reaction string, messageID types.MessageID) error {
 	if messageID == "" {
@@ -226,13 +231,34 @@ type respCreateNewsletter struct {
 	Newsletter *types.NewsletterMetadata `json:"xwa2_newsletter_create"`
 }
 
+// wrapNewsletterMutationError converts known GraphQL error codes from newsletter mutations into typed errors.
+func wrapNewsletterMutationError(err error) error {
+	var gqlErr types.GraphQLError
+	if !errors.As(err, &gqlErr) {
+		return err
+	}
+	switch gqlErr.Extensions.ErrorCode {
+	case 409:
+		return fmt.Errorf("%w: %w", ErrNewsletterNameTaken, err)
+	case 429:
+		return fmt.Errorf("%w: %w", ErrNewsletterRateLimited, err)
+	default:
+		return err
+	}
+}
+
 // CreateNewsletter creates a new WhatsApp channel.
+// The returned metadata contains the JID of the channel, which can be used to immediately post to it.
+//
+// Before creating channels for the first time, the terms of service must be accepted using AcceptTOSNotice.
+// ErrNewsletterNameTaken is returned if the name is already used by another channel,
+// and ErrNewsletterRateLimited if too many channels have been created recently.
 func (cli *Client) CreateNewsletter(params CreateNewsletterParams) (*types.NewsletterMetadata, error) {
 	resp, err := cli.sendMexIQ(context.TODO(), mutationCreateNewsletter, map[string]any{
 		"newsletter_input": &params,
 	})
 	if err != nil {
-		return nil, err
+		return nil, wrapNewsletterMutationError(err)
 	}
 	var respData respCreateNewsletter
 	err = json.Unmarshal(resp, &respData)
@@ -242,6 +268,36 @@ func (cli *Client) CreateNewsletter(params CreateNewsletterParams) (*types.Newsl
 	return respData.Newsletter, nil
 }
 
+func (cli *Client) updateNewsletter(jid types.JID, updates map[string]any) error {
+	_, err := cli.sendMexIQ(context.TODO(), mutationUpdateNewsletter, map[string]any{
+		"newsletter_id": jid.String(),
+		"updates":       updates,
+	})
+	return wrapNewsletterMutationError(err)
+}
+
+// UpdateNewsletterName changes the name of a channel that you're an admin of.
+//
+// ErrNewsletterNameTaken is returned if the name is already used by another channel.
+func (cli *Client) UpdateNewsletterName(jid types.JID, name string) error {
+	return cli.updateNewsletter(jid, map[string]any{"name": name})
+}
+
+// UpdateNewsletterDescription changes the description of a channel that you're an admin of.
+func (cli *Client) UpdateNewsletterDescription(jid types.JID, description string) error {
+	return cli.updateNewsletter(jid, map[string]any{"description": description})
+}
+
+// UpdateNewsletterPicture changes the picture of a channel that you're an admin of.
+// The picture should be a JPEG image. Set it to nil to remove the current picture.
+func (cli *Client) UpdateNewsletterPicture(jid types.JID, picture []byte) error {
+	encodedPicture := ""
+	if picture != nil {
+		encodedPicture = base64.StdEncoding.EncodeToString(picture)
+	}
+	return cli.updateNewsletter(jid, map[string]any{"picture": encodedPicture})
+}
+
 // AcceptTOSNotice accepts a ToS notice.
 //
 // To accept the terms for creating newsletters, use
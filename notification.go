@@ -9,7 +9,9 @@ package whatsmeow
 import (
 	"encoding/json"
 	"errors"
+	"time"
 
+	"go.mau.fi/util/jsontime"
 	"google.golang.org/protobuf/proto"
 
 	"go.mau.fi/whatsmeow/appstate"
@@ -31,12 +33,21 @@ func (cli *Client) handleEncryptNotification(node *waBinary.Node) {
 			return
 		}
 		cli.Log.Infof("Got prekey count from server: %s", node.XMLString())
+		cli.dispatchEvent(&events.PreKeyCountFromServer{
+			Count:      otksLeft,
+			Timestamp:  node.AttrGetter().UnixTime("t"),
+			WillRefill: otksLeft < MinPreKeyCount,
+		})
 		if otksLeft < MinPreKeyCount {
-			cli.uploadPreKeys()
+			cli.uploadPreKeys(otksLeft)
 		}
 	} else if _, ok := node.GetOptionalChildByTag("identity"); ok {
 		cli.Log.Debugf("Got identity change for %s: %s, deleting all identities/sessions for that number", from, node.XMLString())
-		err := cli.Store.Identities.DeleteAllIdentities(from.User)
+		oldKey, err := cli.Store.Identities.GetIdentity(from.SignalAddressString())
+		if err != nil {
+			cli.Log.Warnf("Failed to get old identity of %s from store: %v", from, err)
+		}
+		err = cli.Store.Identities.DeleteAllIdentities(from.User)
 		if err != nil {
 			cli.Log.Warnf("Failed to delete all identities of %s from store after identity change: %v", from, err)
 		}
@@ -45,7 +56,7 @@ func (cli *Client) handleEncryptNotification(node *waBinary.Node) {
 			cli.Log.Warnf("Failed to delete all sessions of %s from store after identity change: %v", from, err)
 		}
 		ts := node.AttrGetter().UnixTime("t")
-		cli.dispatchEvent(&events.IdentityChange{JID: from, Timestamp: ts})
+		cli.dispatchEvent(&events.IdentityChange{JID: from, Timestamp: ts, AutoAccepted: true, OldIdentityKey: oldKey})
 	} else {
 		cli.Log.Debugf("Got unknown encryption notification from server: %s", node.XMLString())
 	}
@@ -108,6 +119,7 @@ func (cli *Client) handleDeviceNotification(node *waBinary.Node) {
 	for _, child := range node.GetChildren() {
 		if child.Tag != "add" && child.Tag != "remove" {
 			cli.Log.Debugf("Unknown device list change tag %s", child.Tag)
+			cli.dispatchUnhandledNode(node.Tag, &child)
 			continue
 		}
 		cag := child.AttrGetter()
@@ -123,6 +135,11 @@ func (cli *Client) handleDeviceNotification(node *waBinary.Node) {
 					cached.devices = append(cached.devices[:i], cached.devices[i+1:]...)
 				}
 			}
+			// The removed device won't be used anymore, so there's no need to keep the session around
+			err := cli.Store.Sessions.DeleteSession(changedDeviceJID.SignalAddressString())
+			if err != nil {
+				cli.Log.Warnf("Failed to delete session of removed device %s: %v", changedDeviceJID, err)
+			}
 		case "update":
 			// ???
 		}
@@ -153,13 +170,6 @@ func (cli *Client) handleOwnDevicesNotification(node *waBinary.Node) {
 		cli.Log.Debugf("Ignoring own device change notification, session was deleted")
 		return
 	}
-	cached, ok := cli.userDevicesCache[ownID]
-	if !ok {
-		cli.Log.Debugf("Ignoring own device change notification, device list not cached")
-		return
-	}
-	oldHash := participantListHashV2(cached.devices)
-	expectedNewHash := node.AttrGetter().String("dhash")
 	var newDeviceList []types.JID
 	for _, child := range node.GetChildren() {
 		jid := child.AttrGetter().JID("jid")
@@ -167,14 +177,23 @@ func (cli *Client) handleOwnDevicesNotification(node *waBinary.Node) {
 			newDeviceList = append(newDeviceList, jid)
 		}
 	}
+	cached, ok := cli.userDevicesCache[ownID]
+	expectedNewHash := node.AttrGetter().String("dhash")
 	newHash := participantListHashV2(newDeviceList)
 	if newHash != expectedNewHash {
-		cli.Log.Debugf("Received own device list change notification %s -> %s, but expected hash was %s", oldHash, newHash, expectedNewHash)
+		cli.Log.Debugf("Received own device list change notification with hash %s, but expected hash was %s", newHash, expectedNewHash)
 		delete(cli.userDevicesCache, ownID)
-	} else {
-		cli.Log.Debugf("Received own device list change notification %s -> %s", oldHash, newHash)
-		cli.userDevicesCache[ownID] = deviceCache{devices: newDeviceList, dhash: expectedNewHash}
+		return
 	}
+	cli.userDevicesCache[ownID] = deviceCache{devices: newDeviceList, dhash: expectedNewHash}
+	if !ok {
+		cli.Log.Debugf("Own device list changed to %s, but previous list wasn't cached", newHash)
+		go cli.dispatchEvent(&events.LinkedDevicesChanged{Devices: newDeviceList})
+		return
+	}
+	cli.Log.Debugf("Received own device list change notification %s -> %s", participantListHashV2(cached.devices), newHash)
+	added, removed := diffDeviceLists(cached.devices, newDeviceList)
+	go cli.dispatchEvent(&events.LinkedDevicesChanged{Devices: newDeviceList, Added: added, Removed: removed})
 }
 
 func (cli *Client) handleBlocklist(node *waBinary.Node) {
@@ -196,11 +215,14 @@ func (cli *Client) handleBlocklist(node *waBinary.Node) {
 		}
 		evt.Changes = append(evt.Changes, change)
 	}
+	cli.updateBlocklistCache(&evt)
 	cli.dispatchEvent(&evt)
 }
 
 func (cli *Client) handleAccountSyncNotification(node *waBinary.Node) {
+	evt := &events.AccountSync{Timestamp: node.AttrGetter().UnixTime("t")}
 	for _, child := range node.GetChildren() {
+		evt.Items = append(evt.Items, child.Tag)
 		switch child.Tag {
 		case "privacy":
 			cli.handlePrivacySettingsNotification(&child)
@@ -215,8 +237,37 @@ func (cli *Client) handleAccountSyncNotification(node *waBinary.Node) {
 			cli.handleBlocklist(&child)
 		default:
 			cli.Log.Debugf("Unhandled account sync item %s", child.Tag)
+			unknownItem := child
+			evt.UnknownItems = append(evt.UnknownItems, &unknownItem)
+			cli.dispatchUnhandledNode(node.Tag, &unknownItem)
 		}
 	}
+	cli.dispatchEvent(evt)
+}
+
+func (cli *Client) handleDisappearingModeNotification(node *waBinary.Node) {
+	child, ok := node.GetOptionalChildByTag("disappearing_mode")
+	if !ok {
+		cli.Log.Warnf("disappearing_mode notification didn't contain <disappearing_mode> tag")
+		return
+	}
+	ag := child.AttrGetter()
+	timer := time.Duration(ag.Int("duration")) * time.Second
+	ts := ag.OptionalUnixTime("t")
+	if !ag.OK() {
+		cli.Log.Warnf("Failed to parse disappearing_mode notification: %v", ag.Error())
+		return
+	}
+	cli.Store.DefaultDisappearingTimer = timer
+	err := cli.Store.Save()
+	if err != nil {
+		cli.Log.Errorf("Failed to save device store after updating default disappearing timer: %v", err)
+	}
+	cli.dispatchEvent(&events.DisappearingTimerChanged{
+		IsDefault: true,
+		Timer:     timer,
+		Timestamp: ts,
+	})
 }
 
 func (cli *Client) handlePrivacyTokenNotification(node *waBinary.Node) {
@@ -306,22 +357,53 @@ func (cli *Client) parseNewsletterMessages(node *waBinary.Node) []*types.Newslet
 
 func (cli *Client) handleNewsletterNotification(node *waBinary.Node) {
 	ag := node.AttrGetter()
-	liveUpdates := node.GetChildByTag("live_updates")
-	cli.dispatchEvent(&events.NewsletterLiveUpdate{
+	liveUpdates, ok := node.GetOptionalChildByTag("live_updates")
+	if !ok {
+		cli.Log.Debugf("Unhandled newsletter notification without live updates: %s", node.XMLString())
+		cli.dispatchUnhandledNode(node.Tag, node)
+		cli.dispatchEvent(&events.UnknownNotification{Type: "newsletter", Node: node})
+		return
+	}
+	evt := &events.NewsletterLiveUpdate{
 		JID:      ag.JID("from"),
 		Time:     ag.UnixTime("t"),
 		Messages: cli.parseNewsletterMessages(&liveUpdates),
-	})
+	}
+	if !ag.OK() {
+		cli.Log.Warnf("Failed to parse newsletter live update notification: %v", ag.Error())
+		return
+	}
+	cli.dispatchEvent(evt)
 }
 
 type newsLetterEventWrapper struct {
 	Data newsletterEvent `json:"data"`
 }
 
+type newsletterEventUser struct {
+	ID       types.JID `json:"id"`
+	PushName string    `json:"push_name"`
+}
+
+type newsletterJoinRequestEvent struct {
+	ID           types.JID           `json:"id"`
+	Requester    newsletterEventUser `json:"requester"`
+	CreationTime jsontime.UnixString `json:"creation_time"`
+}
+
+type newsletterAdminInviteEvent struct {
+	ID             types.JID           `json:"id"`
+	Inviter        newsletterEventUser `json:"inviter"`
+	Revoked        bool                `json:"revoked"`
+	ExpirationTime jsontime.UnixString `json:"expiration_time"`
+}
+
 type newsletterEvent struct {
-	Join       *events.NewsletterJoin       `json:"xwa2_notify_newsletter_on_join"`
-	Leave      *events.NewsletterLeave      `json:"xwa2_notify_newsletter_on_leave"`
-	MuteChange *events.NewsletterMuteChange `json:"xwa2_notify_newsletter_on_mute_change"`
+	Join        *events.NewsletterJoin       `json:"xwa2_notify_newsletter_on_join"`
+	Leave       *events.NewsletterLeave      `json:"xwa2_notify_newsletter_on_leave"`
+	MuteChange  *events.NewsletterMuteChange `json:"xwa2_notify_newsletter_on_mute_change"`
+	JoinRequest *newsletterJoinRequestEvent  `json:"xwa2_notify_newsletter_on_join_request"`
+	AdminInvite *newsletterAdminInviteEvent  `json:"xwa2_notify_newsletter_on_admin_invite"`
 	// _on_admin_metadata_update -> id, thread_metadata, messages
 	// _on_metadata_update
 	// _on_state_change -> id, is_requestor, state
@@ -348,17 +430,37 @@ func (cli *Client) handleMexNotification(node *waBinary.Node) {
 			cli.dispatchEvent(wrapper.Data.Leave)
 		} else if wrapper.Data.MuteChange != nil {
 			cli.dispatchEvent(wrapper.Data.MuteChange)
+		} else if req := wrapper.Data.JoinRequest; req != nil {
+			cli.dispatchEvent(&events.NewsletterJoinRequest{
+				ID:        req.ID,
+				User:      req.Requester.ID,
+				PushName:  req.Requester.PushName,
+				Timestamp: req.CreationTime.Time,
+			})
+		} else if invite := wrapper.Data.AdminInvite; invite != nil {
+			cli.dispatchEvent(&events.NewsletterAdminInvite{
+				ID:        invite.ID,
+				Inviter:   invite.Inviter.ID,
+				PushName:  invite.Inviter.PushName,
+				Revoked:   invite.Revoked,
+				ExpiresAt: invite.ExpirationTime.Time,
+			})
+		} else {
+			cli.Log.Debugf("Unhandled mex update: %s", childData)
+			cli.dispatchUnhandledNode(node.Tag, &child)
 		}
 	}
 }
 
 func (cli *Client) handleNotification(node *waBinary.Node) {
+	// Always ack notifications, even if they're not handled, to prevent the server from resending them.
+	cli.goTracked(func() { cli.sendAck(node) })
 	ag := node.AttrGetter()
 	notifType := ag.String("type")
 	if !ag.OK() {
+		cli.Log.Warnf("Got notification without type: %s", node.XMLString())
 		return
 	}
-	go cli.sendAck(node)
 	switch notifType {
 	case "encrypt":
 		go cli.handleEncryptNotification(node)
@@ -376,6 +478,29 @@ func (cli *Client) handleNotification(node *waBinary.Node) {
 			cli.Log.Errorf("Failed to parse group notification: %v", err)
 		} else {
 			go cli.dispatchEvent(evt)
+			if groupChange, ok := evt.(*events.GroupInfo); ok && len(groupChange.Join) > 0 {
+				go cli.handleSelfJoin(groupChange)
+			}
+			if groupChange, ok := evt.(*events.GroupInfo); ok && len(groupChange.Leave) > 0 && cli.RotateSenderKeyOnLeave {
+				go func() {
+					err := cli.RotateSenderKey(groupChange.JID)
+					if err != nil {
+						cli.Log.Warnf("Failed to rotate sender key in %s after participants left: %v", groupChange.JID, err)
+					}
+				}()
+			}
+			if groupChange, ok := evt.(*events.GroupInfo); ok && groupChange.Ephemeral != nil {
+				changeEvt := &events.DisappearingTimerChanged{
+					Chat:      groupChange.JID,
+					Timer:     time.Duration(groupChange.Ephemeral.DisappearingTimer) * time.Second,
+					Timestamp: groupChange.Timestamp,
+				}
+				if groupChange.Sender != nil {
+					changeEvt.Sender = *groupChange.Sender
+				}
+				cli.cacheDisappearingTimer(changeEvt.Chat, changeEvt.Timer, changeEvt.Timestamp)
+				go cli.dispatchEvent(changeEvt)
+			}
 		}
 	case "picture":
 		go cli.handlePictureNotification(node)
@@ -389,8 +514,12 @@ func (cli *Client) handleNotification(node *waBinary.Node) {
 		go cli.handleNewsletterNotification(node)
 	case "mex":
 		go cli.handleMexNotification(node)
-	// Other types: business, disappearing_mode, server, status, pay, psa
+	case "disappearing_mode":
+		go cli.handleDisappearingModeNotification(node)
+	// Other types: business, server, status, pay, psa
 	default:
 		cli.Log.Debugf("Unhandled notification with type %s", notifType)
+		cli.dispatchUnhandledNode(node.Tag, node)
+		go cli.dispatchEvent(&events.UnknownNotification{Type: notifType, Node: node})
 	}
 }
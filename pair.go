@@ -11,8 +11,10 @@ import (
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"go.mau.fi/libsignal/ecc"
 	"google.golang.org/protobuf/proto"
@@ -65,9 +67,72 @@ func (cli *Client) handlePairDevice(node *waBinary.Node) {
 		evt.Codes = append(evt.Codes, cli.makeQRData(string(content)))
 	}
 
+	cli.pairingInProgress.Store(true)
+	if len(evt.Codes) > 0 {
+		validFor := firstQRCodeTimeout + time.Duration(len(evt.Codes)-1)*nextQRCodeTimeout
+		cli.qrCodesExpireAt.Store(time.Now().Add(validFor).UnixNano())
+	}
+	if cli.AutoRotateQR {
+		go cli.rotateQRCodes(cli.startQRRotation(), evt.Codes)
+		return
+	}
 	cli.dispatchEvent(evt)
 }
 
+const (
+	firstQRCodeTimeout = 60 * time.Second
+	nextQRCodeTimeout  = 20 * time.Second
+)
+
+// qrCodeExpirySlack is how much earlier than the calculated expiry time a server disconnect is still considered
+// to be caused by the QR codes running out, as the server's timer doesn't start exactly when the codes are received.
+const qrCodeExpirySlack = 5 * time.Second
+
+// qrCodesExhausted returns true if all the QR codes received from the server should have expired by now.
+func (cli *Client) qrCodesExhausted() bool {
+	expireAt := cli.qrCodesExpireAt.Load()
+	return expireAt != 0 && !time.Now().Before(time.Unix(0, expireAt).Add(-qrCodeExpirySlack))
+}
+
+// startQRRotation stops the previous QR rotation (if any) and returns the stop channel for a new one.
+func (cli *Client) startQRRotation() <-chan struct{} {
+	cli.qrRotationLock.Lock()
+	defer cli.qrRotationLock.Unlock()
+	if cli.qrRotationStop != nil {
+		close(cli.qrRotationStop)
+	}
+	cli.qrRotationStop = make(chan struct{})
+	return cli.qrRotationStop
+}
+
+func (cli *Client) stopQRRotation() {
+	cli.qrRotationLock.Lock()
+	defer cli.qrRotationLock.Unlock()
+	if cli.qrRotationStop != nil {
+		close(cli.qrRotationStop)
+		cli.qrRotationStop = nil
+	}
+}
+
+// rotateQRCodes emits the given QR codes one by one for Client.AutoRotateQR.
+// After all of them have expired, the server closes the connection, and onDisconnect takes care of reconnecting.
+func (cli *Client) rotateQRCodes(stop <-chan struct{}, codes []string) {
+	for i, code := range codes {
+		timeout := nextQRCodeTimeout
+		if i == 0 {
+			timeout = firstQRCodeTimeout
+		}
+		cli.dispatchEvent(&events.QR{Codes: []string{code}, Timeout: timeout})
+		select {
+		case <-time.After(timeout):
+		case <-stop:
+			cli.Log.Debugf("Stopping QR rotation")
+			return
+		}
+	}
+	cli.Log.Debugf("All QR codes expired, waiting for server to close the connection")
+}
+
 func (cli *Client) makeQRData(ref string) string {
 	noise := base64.StdEncoding.EncodeToString(cli.Store.NoiseKey.Pub[:])
 	identity := base64.StdEncoding.EncodeToString(cli.Store.IdentityKey.Pub[:])
@@ -76,6 +141,8 @@ func (cli *Client) makeQRData(ref string) string {
 }
 
 func (cli *Client) handlePairSuccess(node *waBinary.Node) {
+	cli.stopQRRotation()
+	cli.pairingInProgress.Store(false)
 	id := node.Attrs["id"].(string)
 	pairSuccess := node.GetChildByTag("pair-success")
 
@@ -89,7 +156,7 @@ func (cli *Client) handlePairSuccess(node *waBinary.Node) {
 		if err != nil {
 			cli.Log.Errorf("Failed to pair device: %v", err)
 			cli.Disconnect()
-			cli.dispatchEvent(&events.PairError{ID: jid, BusinessName: businessName, Platform: platform, Error: err})
+			cli.dispatchEvent(&events.PairError{ID: jid, BusinessName: businessName, Platform: platform, Reason: getPairErrorReason(err), Error: err})
 		} else {
 			cli.Log.Infof("Successfully paired %s", cli.Store.ID)
 			cli.dispatchEvent(&events.PairSuccess{ID: jid, BusinessName: businessName, Platform: platform})
@@ -97,6 +164,17 @@ func (cli *Client) handlePairSuccess(node *waBinary.Node) {
 	}()
 }
 
+func getPairErrorReason(err error) events.PairErrorReason {
+	switch {
+	case errors.Is(err, ErrPairInvalidDeviceIdentityHMAC), errors.Is(err, ErrPairInvalidDeviceSignature):
+		return events.PairErrorVerification
+	case errors.Is(err, ErrPairRejectedLocally):
+		return events.PairErrorRejected
+	default:
+		return events.PairErrorInternal
+	}
+}
+
 func (cli *Client) handlePair(deviceIdentityBytes []byte, reqID, businessName, platform string, jid types.JID) error {
 	var deviceIdentityContainer waProto.ADVSignedDeviceIdentityHMAC
 	err := proto.Unmarshal(deviceIdentityBytes, &deviceIdentityContainer)
@@ -160,7 +238,7 @@ func (cli *Client) handlePair(deviceIdentityBytes []byte, reqID, businessName, p
 		cli.sendPairError(reqID, 500, "internal-error")
 		return &PairDatabaseError{"failed to save device store", err}
 	}
-	err = cli.Store.Identities.PutIdentity(mainDeviceJID.SignalAddress().String(), mainDeviceIdentity)
+	err = cli.Store.Identities.PutIdentity(mainDeviceJID.SignalAddressString(), mainDeviceIdentity)
 	if err != nil {
 		_ = cli.Store.Delete()
 		cli.sendPairError(reqID, 500, "internal-error")
@@ -0,0 +1,59 @@
+// Copyright (c) 2022 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"time"
+
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// LastPrimaryDeviceActivity returns the time when a message or receipt was last received from the primary device
+// (phone), or the zero time if nothing has been received since the client was created.
+//
+// This isn't stored in the database, so apps that want to warn users about the phone being offline for days
+// should persist it and restore it with SetLastPrimaryDeviceActivity after restarting.
+func (cli *Client) LastPrimaryDeviceActivity() time.Time {
+	ts := cli.lastPrimaryActivity.Load()
+	if ts == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, ts)
+}
+
+// SetLastPrimaryDeviceActivity restores the last primary device activity timestamp, e.g. after restarting.
+// Newer timestamps that have already been recorded are not overwritten.
+func (cli *Client) SetLastPrimaryDeviceActivity(ts time.Time) {
+	for {
+		prev := cli.lastPrimaryActivity.Load()
+		if ts.UnixNano() <= prev || cli.lastPrimaryActivity.CompareAndSwap(prev, ts.UnixNano()) {
+			return
+		}
+	}
+}
+
+func (cli *Client) markPrimaryDeviceActivity(ts time.Time) {
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+	cli.SetLastPrimaryDeviceActivity(ts)
+	// Old messages (e.g. from the offline queue) don't mean the phone is back online
+	if time.Since(ts) < cli.PhoneOfflineThreshold && cli.phoneOffline.CompareAndSwap(true, false) {
+		cli.Log.Debugf("Primary device is back online")
+		go cli.dispatchEvent(&events.PhoneReconnected{LastActivity: cli.LastPrimaryDeviceActivity()})
+	}
+}
+
+func (cli *Client) checkPhoneOffline() {
+	lastActivity := cli.LastPrimaryDeviceActivity()
+	if cli.PhoneOfflineThreshold <= 0 || lastActivity.IsZero() || time.Since(lastActivity) < cli.PhoneOfflineThreshold {
+		return
+	} else if cli.phoneOffline.CompareAndSwap(false, true) {
+		cli.Log.Debugf("Nothing received from primary device since %s, emitting PhoneOffline", lastActivity)
+		go cli.dispatchEvent(&events.PhoneOffline{LastActivity: lastActivity})
+	}
+}
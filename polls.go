@@ -0,0 +1,155 @@
+// Copyright (c) 2022 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// PollResults contains the aggregated votes of a poll (see Client.GetPollResults).
+type PollResults struct {
+	// The option names in the order they were in the poll creation message.
+	Options []string
+	// The number of votes for each option name.
+	Counts map[string]int
+	// The users who voted for each option name.
+	Voters map[string][]types.JID
+	// The total number of users who have voted. Users can select multiple options in some polls,
+	// so this may be less than the sum of Counts.
+	TotalVoters int
+}
+
+func getPollCreation(msg *waProto.Message) *waProto.PollCreationMessage {
+	if poll := msg.GetPollCreationMessage(); poll != nil {
+		return poll
+	} else if poll = msg.GetPollCreationMessageV2(); poll != nil {
+		return poll
+	}
+	return msg.GetPollCreationMessageV3()
+}
+
+func (cli *Client) storePollOptions(chat, sender types.JID, id types.MessageID, poll *waProto.PollCreationMessage) {
+	options := make([]string, len(poll.GetOptions()))
+	for i, option := range poll.GetOptions() {
+		options[i] = option.GetOptionName()
+	}
+	err := cli.Store.Polls.PutPollOptions(chat, sender, id, options)
+	if err != nil {
+		cli.Log.Errorf("Failed to store options of poll %s: %v", id, err)
+	}
+}
+
+// storePollMessage stores the options of poll creation messages and the votes in poll update messages,
+// so that they can be aggregated with GetPollResults later.
+func (cli *Client) storePollMessage(evt *events.Message) {
+	if !cli.StorePolls || cli.Store.Polls == nil {
+		return
+	}
+	if poll := getPollCreation(evt.Message); poll != nil {
+		cli.storePollOptions(evt.Info.Chat, evt.Info.Sender, evt.Info.ID, poll)
+	} else if pollUpdate := evt.Message.GetPollUpdateMessage(); pollUpdate != nil {
+		vote, err := cli.DecryptPollVote(evt)
+		if err != nil {
+			cli.Log.Warnf("Failed to decrypt poll vote %s from %s to store it: %v", evt.Info.ID, evt.Info.Sender, err)
+			return
+		}
+		pollKey := pollUpdate.GetPollCreationMessageKey()
+		pollSender, err := getOrigSenderFromKey(evt, pollKey)
+		if err != nil {
+			cli.Log.Warnf("Failed to get sender of poll voted in %s: %v", evt.Info.ID, err)
+			return
+		}
+		timestamp := evt.Info.ClientTimestamp
+		if timestamp.IsZero() {
+			timestamp = evt.Info.Timestamp
+		}
+		err = cli.Store.Polls.PutPollVote(evt.Info.Chat, pollSender, pollKey.GetId(), store.PollVote{
+			Voter:           evt.Info.Sender,
+			SelectedOptions: vote.GetSelectedOptions(),
+			Timestamp:       timestamp,
+		})
+		if err != nil {
+			cli.Log.Errorf("Failed to store poll vote %s from %s: %v", evt.Info.ID, evt.Info.Sender, err)
+		}
+	}
+}
+
+// storeOutgoingPollMessage stores poll data from messages sent by this device.
+func (cli *Client) storeOutgoingPollMessage(to, ownID types.JID, resp SendResponse, message *waProto.Message) {
+	if !cli.StorePolls || cli.Store.Polls == nil {
+		return
+	} else if getPollCreation(message) == nil && message.GetPollUpdateMessage() == nil {
+		return
+	}
+	cli.storePollMessage(&events.Message{
+		Info: types.MessageInfo{
+			MessageSource: types.MessageSource{
+				Chat:     to,
+				Sender:   ownID.ToNonAD(),
+				IsFromMe: true,
+				IsGroup:  to.Server == types.GroupServer,
+			},
+			ID:              resp.ID,
+			Timestamp:       resp.Timestamp,
+			ClientTimestamp: getClientTimestamp(message),
+		},
+		Message: message,
+	})
+}
+
+// GetPollResults aggregates the votes of the given poll. Only the latest vote of each user is counted,
+// so users changing their vote don't cause double counting.
+//
+// If Client.StorePolls is enabled, votes are collected automatically from incoming poll update messages and ones
+// sent with this client, as long as the poll creation message was also received or sent while storing was enabled.
+// Votes received before the poll creation message can't be decrypted and are therefore not counted.
+func (cli *Client) GetPollResults(pollInfo *types.MessageInfo) (*PollResults, error) {
+	if cli.Store.Polls == nil {
+		return nil, ErrNoPollStore
+	}
+	options, err := cli.Store.Polls.GetPollOptions(pollInfo.Chat, pollInfo.Sender, pollInfo.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get poll options: %w", err)
+	} else if len(options) == 0 {
+		return nil, ErrPollNotFound
+	}
+	votes, err := cli.Store.Polls.GetPollVotes(pollInfo.Chat, pollInfo.Sender, pollInfo.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get poll votes: %w", err)
+	}
+	results := &PollResults{
+		Options: options,
+		Counts:  make(map[string]int, len(options)),
+		Voters:  make(map[string][]types.JID, len(options)),
+	}
+	optionsByHash := make(map[string]string, len(options))
+	for i, hash := range HashPollOptions(options) {
+		optionsByHash[hex.EncodeToString(hash)] = options[i]
+		results.Counts[options[i]] = 0
+	}
+	for _, vote := range votes {
+		if len(vote.SelectedOptions) > 0 {
+			results.TotalVoters++
+		}
+		for _, hash := range vote.SelectedOptions {
+			option, ok := optionsByHash[hex.EncodeToString(hash)]
+			if !ok {
+				cli.Log.Debugf("Unknown option hash %X in vote from %s in poll %s", hash, vote.Voter, pollInfo.ID)
+				continue
+			}
+			results.Counts[option]++
+			results.Voters[option] = append(results.Voters[option], vote.Voter)
+		}
+	}
+	return results, nil
+}
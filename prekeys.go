@@ -29,6 +29,20 @@ const (
 	MinPreKeyCount = 5
 )
 
+func (cli *Client) getPreKeyUploadTarget() int {
+	if cli.PreKeyUploadTarget > 0 {
+		return cli.PreKeyUploadTarget
+	}
+	return WantedPreKeyCount
+}
+
+func (cli *Client) getMinPreKeyUploadBatch() int {
+	if cli.MinPreKeyUploadBatch > 0 {
+		return cli.MinPreKeyUploadBatch
+	}
+	return MinPreKeyCount
+}
+
 func (cli *Client) getServerPreKeyCount() (int, error) {
 	resp, err := cli.sendIQ(infoQuery{
 		Namespace: "encrypt",
@@ -47,19 +61,28 @@ func (cli *Client) getServerPreKeyCount() (int, error) {
 	return val, ag.Error()
 }
 
-func (cli *Client) uploadPreKeys() {
+func (cli *Client) uploadPreKeys(serverCount int) {
 	cli.uploadPreKeysLock.Lock()
 	defer cli.uploadPreKeysLock.Unlock()
+	target := cli.getPreKeyUploadTarget()
+	// If there was a recent upload, multiple refill triggers (e.g. connect success and an encrypt notification)
+	// may have raced, so re-check the count on the server instead of trusting the count from the trigger.
 	if cli.lastPreKeyUpload.Add(10 * time.Minute).After(time.Now()) {
-		sc, _ := cli.getServerPreKeyCount()
-		if sc >= WantedPreKeyCount {
+		sc, err := cli.getServerPreKeyCount()
+		if err == nil && sc >= target {
 			cli.Log.Debugf("Canceling prekey upload request due to likely race condition")
 			return
+		} else if err == nil {
+			serverCount = sc
 		}
 	}
+	count := target - serverCount
+	if minBatch := cli.getMinPreKeyUploadBatch(); count < minBatch {
+		count = minBatch
+	}
 	var registrationIDBytes [4]byte
 	binary.BigEndian.PutUint32(registrationIDBytes[:], cli.Store.RegistrationID)
-	preKeys, err := cli.Store.PreKeys.GetOrGenPreKeys(WantedPreKeyCount)
+	preKeys, err := cli.Store.PreKeys.GetOrGenPreKeys(uint32(count))
 	if err != nil {
 		cli.Log.Errorf("Failed to get prekeys to upload: %v", err)
 		return
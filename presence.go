@@ -7,6 +7,8 @@
 package whatsmeow
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
 	waBinary "go.mau.fi/whatsmeow/binary"
@@ -35,12 +37,44 @@ func (cli *Client) handleChatState(node *waBinary.Node) {
 	}
 }
 
+// popPendingPresenceSubscribe returns true if a subscription result event should be emitted for the given user.
+func (cli *Client) popPendingPresenceSubscribe(jid types.JID) bool {
+	jid = jid.ToNonAD()
+	cli.pendingPresenceSubscribesLock.Lock()
+	defer cli.pendingPresenceSubscribesLock.Unlock()
+	_, pending := cli.pendingPresenceSubscribes[jid]
+	delete(cli.pendingPresenceSubscribes, jid)
+	return pending
+}
+
+// clearPendingPresenceSubscribes forgets all subscriptions that haven't been answered yet.
+// The server won't answer them after the connection is closed, so this is called on every disconnect.
+func (cli *Client) clearPendingPresenceSubscribes() {
+	cli.pendingPresenceSubscribesLock.Lock()
+	clear(cli.pendingPresenceSubscribes)
+	cli.pendingPresenceSubscribesLock.Unlock()
+}
+
 func (cli *Client) handlePresence(node *waBinary.Node) {
 	var evt events.Presence
 	ag := node.AttrGetter()
 	evt.From = ag.JID("from")
 	presenceType := ag.OptionalString("type")
-	if presenceType == "unavailable" {
+	if presenceType == "error" {
+		result := &events.PresenceSubscribeResult{
+			JID:    evt.From.ToNonAD(),
+			Reason: types.PresenceSubscribeReasonDenied,
+		}
+		if errNode, ok := node.GetOptionalChildByTag("error"); ok {
+			errAG := errNode.AttrGetter()
+			result.ErrorCode = errAG.OptionalInt("code")
+			result.ErrorText = errAG.OptionalString("text")
+		}
+		cli.Log.Debugf("Presence subscription to %s failed: %d %s", evt.From, result.ErrorCode, result.ErrorText)
+		cli.popPendingPresenceSubscribe(evt.From)
+		cli.dispatchEvent(result)
+		return
+	} else if presenceType == "unavailable" {
 		evt.Unavailable = true
 	} else if presenceType != "" {
 		cli.Log.Debugf("Unrecognized presence type '%s' in presence event from %s", presenceType, evt.From)
@@ -51,9 +85,16 @@ func (cli *Client) handlePresence(node *waBinary.Node) {
 	}
 	if !ag.OK() {
 		cli.Log.Warnf("Error parsing presence event: %+v", ag.Errors)
-	} else {
-		cli.dispatchEvent(&evt)
+		return
+	}
+	if cli.popPendingPresenceSubscribe(evt.From) {
+		result := &events.PresenceSubscribeResult{JID: evt.From.ToNonAD(), Allowed: true}
+		if lastSeen == "deny" {
+			result.Reason = types.PresenceSubscribeReasonLastSeenHidden
+		}
+		cli.dispatchEvent(result)
 	}
+	cli.dispatchEvent(&evt)
 }
 
 // SendPresence updates the user's presence status on WhatsApp.
@@ -81,6 +122,8 @@ func (cli *Client) SendPresence(state types.Presence) error {
 // SubscribePresence asks the WhatsApp servers to send presence updates of a specific user to this client.
 //
 // After subscribing to this event, you should start receiving *events.Presence for that user in normal event handlers.
+// The first presence update (or an error from the server) is preceded by *events.PresenceSubscribeResult,
+// which tells whether we're allowed to see the user's presence at all.
 //
 // Also, it seems that the WhatsApp servers require you to be online to receive presence status from other users,
 // so you should mark yourself as online before trying to use this function:
@@ -110,7 +153,94 @@ func (cli *Client) SubscribePresence(jid types.JID) error {
 			Content: privacyToken.Token,
 		}}
 	}
-	return cli.sendNode(req)
+	cli.pendingPresenceSubscribesLock.Lock()
+	cli.pendingPresenceSubscribes[jid.ToNonAD()] = struct{}{}
+	cli.pendingPresenceSubscribesLock.Unlock()
+	err = cli.sendNode(req)
+	if err != nil {
+		cli.popPendingPresenceSubscribe(jid)
+	}
+	return err
+}
+
+const defaultPresenceSubscriptionRate = 5
+
+func (cli *Client) subscribePresenceRateLimited(ctx context.Context, jids []types.JID) error {
+	rate := cli.PresenceSubscriptionRate
+	if rate <= 0 {
+		rate = defaultPresenceSubscriptionRate
+	}
+	var errs []error
+	for _, jid := range jids {
+		if err := cli.presenceRateLimiter.wait(ctx, rate, 1); err != nil {
+			return err
+		} else if !cli.IsConnected() {
+			return ErrNotConnected
+		} else if err = cli.SubscribePresence(jid); err != nil {
+			errs = append(errs, fmt.Errorf("failed to subscribe to %s: %w", jid, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// SubscribeToPresenceForAllContacts subscribes to the presence of all the given users, or all contacts
+// in the contact store if the list is empty. The subscriptions are sent at the rate defined by
+// Client.PresenceSubscriptionRate to avoid hitting server-side limits.
+//
+// The users are also remembered, and the subscriptions are automatically renewed after reconnecting,
+// so *events.Presence will keep coming for them until UnsubscribeFromAllPresence is called.
+// As with SubscribePresence, you should mark yourself as online to receive presence updates.
+//
+// Failing to subscribe to individual users doesn't stop the loop, all such errors are returned at the end.
+func (cli *Client) SubscribeToPresenceForAllContacts(ctx context.Context, jids []types.JID) error {
+	var users []types.JID
+	if len(jids) == 0 {
+		contacts, err := cli.Store.Contacts.GetAllContacts()
+		if err != nil {
+			return fmt.Errorf("failed to get contacts: %w", err)
+		}
+		users = make([]types.JID, 0, len(contacts))
+		for jid := range contacts {
+			if jid.Server == types.DefaultUserServer {
+				users = append(users, jid.ToNonAD())
+			}
+		}
+	} else {
+		users = make([]types.JID, len(jids))
+		for i, jid := range jids {
+			users[i] = jid.ToNonAD()
+		}
+	}
+	cli.presenceSubscriptionsLock.Lock()
+	for _, jid := range users {
+		cli.presenceSubscriptions[jid] = struct{}{}
+	}
+	cli.presenceSubscriptionsLock.Unlock()
+	return cli.subscribePresenceRateLimited(ctx, users)
+}
+
+// UnsubscribeFromAllPresence stops renewing the presence subscriptions made with SubscribeToPresenceForAllContacts
+// after reconnecting. The server will stop sending presence updates for those users when the connection is closed.
+func (cli *Client) UnsubscribeFromAllPresence() {
+	cli.presenceSubscriptionsLock.Lock()
+	clear(cli.presenceSubscriptions)
+	cli.presenceSubscriptionsLock.Unlock()
+}
+
+func (cli *Client) resubscribePresence() {
+	cli.presenceSubscriptionsLock.Lock()
+	jids := make([]types.JID, 0, len(cli.presenceSubscriptions))
+	for jid := range cli.presenceSubscriptions {
+		jids = append(jids, jid)
+	}
+	cli.presenceSubscriptionsLock.Unlock()
+	if len(jids) == 0 {
+		return
+	}
+	cli.Log.Debugf("Renewing presence subscriptions of %d users after connecting", len(jids))
+	if err := cli.subscribePresenceRateLimited(context.Background(), jids); err != nil {
+		cli.Log.Warnf("Failed to renew some presence subscriptions: %v", err)
+	}
 }
 
 // SendChatPresence updates the user's typing status in a specific chat.
@@ -7,6 +7,7 @@
 package whatsmeow
 
 import (
+	"fmt"
 	"strconv"
 	"time"
 
@@ -101,7 +102,8 @@ func (cli *Client) SetPrivacySetting(name types.PrivacySettingType, value types.
 	return
 }
 
-// SetDefaultDisappearingTimer will set the default disappearing message timer.
+// SetDefaultDisappearingTimer will set the default disappearing message timer,
+// which is applied to new chats. The new timer is saved in Store.DefaultDisappearingTimer.
 func (cli *Client) SetDefaultDisappearingTimer(timer time.Duration) (err error) {
 	_, err = cli.sendIQ(infoQuery{
 		Namespace: "disappearing_mode",
@@ -114,6 +116,14 @@ func (cli *Client) SetDefaultDisappearingTimer(timer time.Duration) (err error)
 			},
 		}},
 	})
+	if err != nil {
+		return
+	}
+	cli.Store.DefaultDisappearingTimer = timer
+	err = cli.Store.Save()
+	if err != nil {
+		err = fmt.Errorf("failed to save device store after updating default disappearing timer: %w", err)
+	}
 	return
 }
 
@@ -36,7 +36,8 @@ const QRChannelEventError = "error"
 var (
 	// QRChannelSuccess is emitted from GetQRChannel when the pairing is successful.
 	QRChannelSuccess = QRChannelItem{Event: "success"}
-	// QRChannelTimeout is emitted from GetQRChannel if the socket gets disconnected by the server before the pairing is successful.
+	// QRChannelTimeout is emitted from GetQRChannel if all the QR codes expire before the pairing is successful.
+	// If the connection is lost for another reason, an `error` event with ErrPairDisconnected is emitted instead.
 	QRChannelTimeout = QRChannelItem{Event: "timeout"}
 	// QRChannelErrUnexpectedEvent is emitted from GetQRChannel if an unexpected connection event is received,
 	// as that likely means that the pairing has already happened before the channel was set up.
@@ -109,6 +110,18 @@ func (qrc *qrChannel) emitQRs(evt *events.QR) {
 	}
 }
 
+func (qrc *qrChannel) emitRotatedQR(evt *events.QR) {
+	if len(evt.Codes) == 0 {
+		return
+	}
+	qrc.log.Debugf("Emitting rotated QR code %s", evt.Codes[0])
+	select {
+	case qrc.output <- QRChannelItem{Code: evt.Codes[0], Timeout: evt.Timeout, Event: QRChannelEventCode}:
+	default:
+		qrc.log.Debugf("Output channel didn't accept rotated code")
+	}
+}
+
 func (qrc *qrChannel) handleEvent(rawEvt interface{}) {
 	if atomic.LoadUint32(&qrc.closed) == 1 {
 		qrc.log.Debugf("Dropping event of type %T, channel is closed", rawEvt)
@@ -117,6 +130,11 @@ func (qrc *qrChannel) handleEvent(rawEvt interface{}) {
 	var outputType QRChannelItem
 	switch evt := rawEvt.(type) {
 	case *events.QR:
+		if qrc.cli.AutoRotateQR {
+			// The client is already rotating the codes, so just forward the current one.
+			qrc.emitRotatedQR(evt)
+			return
+		}
 		qrc.log.Debugf("Received QR code event, starting to emit codes to channel")
 		go qrc.emitQRs(evt)
 		return
@@ -129,12 +147,20 @@ func (qrc *qrChannel) handleEvent(rawEvt interface{}) {
 	case *events.PairSuccess:
 		outputType = QRChannelSuccess
 	case *events.PairError:
+		if evt.Reason == events.PairErrorTimeout {
+			outputType = QRChannelTimeout
+			break
+		}
 		outputType = QRChannelItem{
 			Event: QRChannelEventError,
 			Error: evt.Error,
 		}
 	case *events.Disconnected:
-		outputType = QRChannelTimeout
+		// If the codes had run out, PairError with PairErrorTimeout would have been dispatched before this.
+		outputType = QRChannelItem{
+			Event: QRChannelEventError,
+			Error: ErrPairDisconnected,
+		}
 	case *events.Connected, *events.ConnectFailure, *events.LoggedOut, *events.TemporaryBan:
 		outputType = QRChannelErrUnexpectedEvent
 	default:
@@ -0,0 +1,99 @@
+// Copyright (c) 2022 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// defaultRateLimitBackoff is the suggested retry delay used when the server doesn't specify one in a rate limit error.
+const defaultRateLimitBackoff = 10 * time.Second
+
+// tokenBucket is a simple token bucket rate limiter used for outgoing info queries.
+type tokenBucket struct {
+	lock     sync.Mutex
+	tokens   float64
+	lastFill time.Time
+}
+
+// refill adds the tokens accumulated since the last call. The lock must be held when calling this.
+func (tb *tokenBucket) refill(rate float64, burst int) {
+	now := time.Now()
+	if tb.lastFill.IsZero() {
+		tb.tokens = float64(burst)
+	} else {
+		tb.tokens = min(tb.tokens+now.Sub(tb.lastFill).Seconds()*rate, float64(burst))
+	}
+	tb.lastFill = now
+}
+
+// take takes a token if one is available without waiting and returns whether a token was taken.
+func (tb *tokenBucket) take(rate float64, burst int) bool {
+	if burst < 1 {
+		burst = 1
+	}
+	tb.lock.Lock()
+	defer tb.lock.Unlock()
+	tb.refill(rate, burst)
+	if tb.tokens < 1 {
+		return false
+	}
+	tb.tokens--
+	return true
+}
+
+// wait blocks until a token is available or the context is canceled.
+//
+// The rate and burst are passed in every time so that changes to the client config are applied immediately.
+func (tb *tokenBucket) wait(ctx context.Context, rate float64, burst int) error {
+	if burst < 1 {
+		burst = 1
+	}
+	tb.lock.Lock()
+	tb.refill(rate, burst)
+	// Take the token immediately, even if it means going negative, so concurrent waiters queue up fairly.
+	tb.tokens--
+	var delay time.Duration
+	if tb.tokens < 0 {
+		delay = time.Duration(-tb.tokens / rate * float64(time.Second))
+	}
+	tb.lock.Unlock()
+	if delay <= 0 {
+		return nil
+	}
+	select {
+	case <-time.After(delay):
+		return nil
+	case <-ctx.Done():
+		// Return the token that wasn't used
+		tb.lock.Lock()
+		tb.tokens++
+		tb.lock.Unlock()
+		return ctx.Err()
+	}
+}
+
+func (cli *Client) waitIQRateLimit(ctx context.Context) error {
+	if cli.IQRateLimit <= 0 {
+		return nil
+	}
+	return cli.iqRateLimiter.wait(ctx, cli.IQRateLimit, cli.IQRateLimitBurst)
+}
+
+func (cli *Client) waitSendRateLimit(ctx context.Context) error {
+	if cli.SendRateLimit <= 0 {
+		return nil
+	} else if cli.SendRateLimitNoWait {
+		if !cli.sendRateLimiter.take(cli.SendRateLimit, cli.SendRateLimitBurst) {
+			return ErrRateLimited
+		}
+		return nil
+	}
+	return cli.sendRateLimiter.wait(ctx, cli.SendRateLimit, cli.SendRateLimitBurst)
+}
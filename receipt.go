@@ -7,10 +7,12 @@
 package whatsmeow
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
 	waBinary "go.mau.fi/whatsmeow/binary"
+	"go.mau.fi/whatsmeow/store"
 	"go.mau.fi/whatsmeow/types"
 	"go.mau.fi/whatsmeow/types/events"
 )
@@ -28,14 +30,109 @@ func (cli *Client) handleReceipt(node *waBinary.Node) {
 				}
 			}()
 		}
+		if receipt.IsFromMe && receipt.Sender.Device == 0 {
+			cli.markPrimaryDeviceActivity(receipt.Timestamp)
+		}
+		if stored, ok := cli.toStoredReceipt(receipt); ok {
+			cli.storeMessageReceipts(receipt.Chat, receipt.MessageIDs, []store.MessageReceipt{stored})
+		}
 		go cli.dispatchEvent(receipt)
 	}
-	go cli.sendAck(node)
+	cli.goTracked(func() { cli.sendAck(node) })
+}
+
+// toStoredReceipt converts a receipt event into the form that's stored for GetMessageReceipts.
+// The second return value is false if the receipt shouldn't be stored.
+func (cli *Client) toStoredReceipt(receipt *events.Receipt) (stored store.MessageReceipt, ok bool) {
+	if !cli.StoreMessageReceipts || cli.Store.Receipts == nil || receipt.IsFromMe {
+		return
+	}
+	stored.Receiver = receipt.Sender
+	switch receipt.Type {
+	case types.ReceiptTypeDelivered:
+		stored.DeliveredAt = receipt.Timestamp
+	case types.ReceiptTypeRead:
+		stored.ReadAt = receipt.Timestamp
+	case types.ReceiptTypePlayed:
+		stored.PlayedAt = receipt.Timestamp
+	default:
+		return
+	}
+	return stored, true
+}
+
+// storeMessageReceipts writes the given receipts to the database in the background, in a single transaction.
+func (cli *Client) storeMessageReceipts(chat types.JID, ids []types.MessageID, receipts []store.MessageReceipt) {
+	cli.goTracked(func() {
+		err := cli.Store.Receipts.PutMessageReceipts(chat, ids, receipts)
+		if err != nil {
+			cli.Log.Warnf("Failed to store %d receipts for %v in %s: %v", len(receipts), ids, chat, err)
+		}
+	})
+}
+
+// MessageReceipts contains the aggregated receipts of a message sent by the user.
+type MessageReceipts struct {
+	// The users who have received the message and when. Users who have read or played the message are always included.
+	Delivered map[types.JID]time.Time
+	// The users who have read the message and when. Users who have played the message are always included.
+	Read map[types.JID]time.Time
+	// The users who have played the message (only applicable to voice messages and other media with a played state).
+	Played map[types.JID]time.Time
+}
+
+// GetMessageReceipts returns the delivery, read and played receipts that have been received for the given message.
+//
+// Receipts are only stored if Client.StoreMessageReceipts is enabled, so only receipts received while this device
+// was connected with that option enabled are included. In groups, this can be combined with the participant list to show e.g. "read by 5/10".
+func (cli *Client) GetMessageReceipts(chat types.JID, id types.MessageID) (*MessageReceipts, error) {
+	if cli.Store.Receipts == nil {
+		return nil, ErrNoReceiptStore
+	}
+	stored, err := cli.Store.Receipts.GetMessageReceipts(chat, id)
+	if err != nil {
+		return nil, err
+	}
+	receipts := &MessageReceipts{
+		Delivered: make(map[types.JID]time.Time),
+		Read:      make(map[types.JID]time.Time),
+		Played:    make(map[types.JID]time.Time),
+	}
+	for _, receipt := range stored {
+		switch {
+		case !receipt.DeliveredAt.IsZero():
+			receipts.Delivered[receipt.Receiver] = receipt.DeliveredAt
+		case !receipt.ReadAt.IsZero():
+			receipts.Delivered[receipt.Receiver] = receipt.ReadAt
+		case !receipt.PlayedAt.IsZero():
+			receipts.Delivered[receipt.Receiver] = receipt.PlayedAt
+		}
+		if !receipt.ReadAt.IsZero() {
+			receipts.Read[receipt.Receiver] = receipt.ReadAt
+		} else if !receipt.PlayedAt.IsZero() {
+			receipts.Read[receipt.Receiver] = receipt.PlayedAt
+		}
+		if !receipt.PlayedAt.IsZero() {
+			receipts.Played[receipt.Receiver] = receipt.PlayedAt
+		}
+	}
+	return receipts, nil
+}
+
+// PruneMessageReceipts deletes the stored receipts of messages whose latest receipt is older than the given time.
+// It returns the number of deleted receipt rows. Receipts are never deleted automatically, so clients that enable
+// StoreMessageReceipts should call this periodically.
+func (cli *Client) PruneMessageReceipts(olderThan time.Time) (int64, error) {
+	if cli.Store.Receipts == nil {
+		return 0, ErrNoReceiptStore
+	}
+	return cli.Store.Receipts.PruneMessageReceipts(olderThan)
 }
 
 func (cli *Client) handleGroupedReceipt(partialReceipt events.Receipt, participants *waBinary.Node) {
 	pag := participants.AttrGetter()
 	partialReceipt.MessageIDs = []types.MessageID{pag.String("key")}
+	var toStore []store.MessageReceipt
 	for _, child := range participants.GetChildren() {
 		if child.Tag != "user" {
 			cli.Log.Warnf("Unexpected node in grouped receipt participants: %s", child.XMLString())
@@ -49,8 +146,14 @@ func (cli *Client) handleGroupedReceipt(partialReceipt events.Receipt, participa
 			cli.Log.Warnf("Failed to parse user node %s in grouped receipt: %v", child.XMLString(), ag.Error())
 			continue
 		}
+		if stored, ok := cli.toStoredReceipt(&receipt); ok {
+			toStore = append(toStore, stored)
+		}
 		go cli.dispatchEvent(&receipt)
 	}
+	if len(toStore) > 0 {
+		cli.storeMessageReceipts(partialReceipt.Chat, partialReceipt.MessageIDs, toStore)
+	}
 }
 
 func (cli *Client) parseReceipt(node *waBinary.Node) (*events.Receipt, error) {
@@ -172,6 +275,50 @@ func (cli *Client) MarkRead(ids []types.MessageID, timestamp time.Time, chat, se
 	return cli.sendNode(node)
 }
 
+// maxReceiptBatchSize is the maximum number of message IDs that MarkReadBatch includes in a single receipt.
+const maxReceiptBatchSize = 200
+
+// MarkReadBatch sends read receipts for many messages at once, e.g. after catching up on a backlog of messages.
+//
+// The messages are grouped by chat and sender, and one receipt is sent for each group (split into multiple receipts
+// if there are lots of messages), instead of one receipt per message. Messages sent by the current user are skipped.
+// The timestamp and optional receipt type work the same way as in MarkRead.
+//
+// Receipts are still sent for the other groups if sending one fails, and all errors are returned joined together.
+func (cli *Client) MarkReadBatch(keys []types.MessageKey, timestamp time.Time, receiptTypeExtra ...types.ReceiptType) error {
+	type receiptGroup struct {
+		chat, sender types.JID
+	}
+	var order []receiptGroup
+	grouped := make(map[receiptGroup][]types.MessageID)
+	for _, key := range keys {
+		if key.IsFromMe {
+			continue
+		}
+		group := receiptGroup{chat: key.Chat, sender: key.Sender.ToNonAD()}
+		if key.Chat.Server == types.DefaultUserServer || key.Chat.Server == types.MessengerServer {
+			// The sender isn't included in receipts for direct chats
+			group.sender = types.EmptyJID
+		}
+		if _, ok := grouped[group]; !ok {
+			order = append(order, group)
+		}
+		grouped[group] = append(grouped[group], key.ID)
+	}
+	var errs []error
+	for _, group := range order {
+		ids := grouped[group]
+		for i := 0; i < len(ids); i += maxReceiptBatchSize {
+			err := cli.MarkRead(ids[i:min(i+maxReceiptBatchSize, len(ids))], timestamp, group.chat, group.sender, receiptTypeExtra...)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to send receipt in %s: %w", group.chat, err))
+				break
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
 // SetForceActiveDeliveryReceipts will force the client to send normal delivery
 // receipts (which will show up as the two gray ticks on WhatsApp), even if the
 // client isn't marked as online.
@@ -0,0 +1,49 @@
+// Copyright (c) 2022 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+)
+
+// BuildReply builds a copy of the given content that quotes the given message, which can be sent using Client.SendMessage.
+//
+// If the quoted message was sent in a chat with disappearing messages, the expiration and ephemeral setting timestamp
+// are copied to the reply, so that the reply disappears the same way. The expiration can be overridden with
+// SendRequestExtra.Expiration if the chat's timer is known to have changed. Other context info of the quoted
+// message (e.g. the message it replied to) is not included in the quote.
+//
+// Plain text messages are converted to ExtendedTextMessage, as Conversation can't have a ContextInfo.
+func (cli *Client) BuildReply(replyTo *events.Message, content *waProto.Message) *waProto.Message {
+	reply := proto.Clone(content).(*waProto.Message)
+	if reply.Conversation != nil {
+		reply.ExtendedTextMessage = &waProto.ExtendedTextMessage{Text: reply.Conversation}
+		reply.Conversation = nil
+	}
+	ctxInfo := types.GetMutableContextInfo(reply)
+	if ctxInfo == nil {
+		return reply
+	}
+	quoted := proto.Clone(replyTo.Message).(*waProto.Message)
+	quoted.MessageContextInfo = nil
+	if quotedCtxInfo := types.GetContextInfo(quoted); quotedCtxInfo != nil {
+		ctxInfo.Expiration = quotedCtxInfo.Expiration
+		ctxInfo.EphemeralSettingTimestamp = quotedCtxInfo.EphemeralSettingTimestamp
+		proto.Reset(quotedCtxInfo)
+	}
+	ctxInfo.StanzaId = proto.String(replyTo.Info.ID)
+	ctxInfo.Participant = proto.String(replyTo.Info.Sender.ToNonAD().String())
+	ctxInfo.QuotedMessage = quoted
+	if replyTo.Info.Chat.Server == types.BroadcastServer {
+		ctxInfo.RemoteJid = proto.String(replyTo.Info.Chat.String())
+	}
+	return reply
+}
@@ -98,6 +98,7 @@ type infoQuery struct {
 	To        types.JID
 	Target    types.JID
 	ID        string
+	SMaxID    string
 	Content   interface{}
 
 	Timeout time.Duration
@@ -121,6 +122,9 @@ func (cli *Client) sendIQAsyncAndGetData(query *infoQuery) (<-chan *waBinary.Nod
 	if !query.Target.IsEmpty() {
 		attrs["target"] = query.Target
 	}
+	if query.SMaxID != "" {
+		attrs["smax_id"] = query.SMaxID
+	}
 	data, err := cli.sendNodeAndGetData(waBinary.Node{
 		Tag:     "iq",
 		Attrs:   attrs,
@@ -141,6 +145,13 @@ func (cli *Client) sendIQAsync(query infoQuery) (<-chan *waBinary.Node, error) {
 const defaultRequestTimeout = 75 * time.Second
 
 func (cli *Client) sendIQ(query infoQuery) (*waBinary.Node, error) {
+	if query.Context == nil {
+		query.Context = context.Background()
+	}
+	err := cli.waitIQRateLimit(query.Context)
+	if err != nil {
+		return nil, err
+	}
 	resChan, data, err := cli.sendIQAsyncAndGetData(&query)
 	if err != nil {
 		return nil, err
@@ -148,9 +159,6 @@ func (cli *Client) sendIQ(query infoQuery) (*waBinary.Node, error) {
 	if query.Timeout == 0 {
 		query.Timeout = defaultRequestTimeout
 	}
-	if query.Context == nil {
-		query.Context = context.Background()
-	}
 	select {
 	case res := <-resChan:
 		if isDisconnectNode(res) {
@@ -209,9 +217,11 @@ func (cli *Client) retryFrame(reqType, id string, data []byte, origResp *waBinar
 	select {
 	case resp = <-respChan:
 	case <-ctx.Done():
+		cli.cancelResponse(id, respChan)
 		return nil, ctx.Err()
 	case <-timeoutChan:
-		// FIXME this error isn't technically correct (but works for now - the timeout param is only used from sendIQ)
+		cli.cancelResponse(id, respChan)
+		// Message sends convert this into a NoAckError with ErrMessageTimedOut
 		return nil, ErrIQTimedOut
 	}
 	if isDisconnectNode(resp) {
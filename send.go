@@ -36,20 +36,31 @@ import (
 
 // GenerateMessageID generates a random string that can be used as a message ID on WhatsApp.
 //
+// The IDs are generated the same way as in WhatsApp web, see BuildMessageID for the exact format.
+// IDs can be generated before sending a message and passed to SendMessage:
+//
 //	msgID := cli.GenerateMessageID()
 //	cli.SendMessage(context.Background(), targetJID, &waProto.Message{...}, whatsmeow.SendRequestExtra{ID: msgID})
 func (cli *Client) GenerateMessageID() types.MessageID {
 	if cli.MessengerConfig != nil {
 		return types.MessageID(strconv.FormatInt(GenerateFacebookMessageID(), 10))
 	}
-	data := make([]byte, 8, 8+20+16)
-	binary.BigEndian.PutUint64(data, uint64(time.Now().Unix()))
-	ownID := cli.getOwnID()
+	return BuildMessageID(cli.getOwnID(), time.Now(), random.Bytes(16))
+}
+
+// BuildMessageID deterministically builds a message ID using the WhatsApp web scheme.
+//
+// The ID is "3EB0" followed by the first 9 bytes (as uppercase hex) of a SHA-256 hash over the big-endian unix timestamp,
+// the sender's phone number with the legacy @c.us suffix (omitted if ownID is empty) and the given random bytes.
+// GenerateMessageID uses 16 random bytes, but passing fixed values here is useful for reproducible tests.
+func BuildMessageID(ownID types.JID, timestamp time.Time, randomData []byte) types.MessageID {
+	data := make([]byte, 8, 8+20+len(randomData))
+	binary.BigEndian.PutUint64(data, uint64(timestamp.Unix()))
 	if !ownID.IsEmpty() {
 		data = append(data, []byte(ownID.User)...)
 		data = append(data, []byte("@c.us")...)
 	}
-	data = append(data, random.Bytes(16)...)
+	data = append(data, randomData...)
 	hash := sha256.Sum256(data)
 	return "3EB0" + strings.ToUpper(hex.EncodeToString(hash[:9]))
 }
@@ -133,10 +144,30 @@ type SendRequestExtra struct {
 	Peer bool
 	// A timeout for the send request. Unlike timeouts using the context parameter, this only applies
 	// to the actual response waiting and not preparing/encrypting the message.
-	// Defaults to 75 seconds. The timeout can be disabled by using a negative value.
+	// Defaults to Client.MessageAckTimeout. The timeout can be disabled by using a negative value.
 	Timeout time.Duration
 	// When sending media to newsletters, the Handle field returned by the file upload.
 	MediaHandle string
+	// Overrides the mediatype attribute that is normally determined automatically from the message content.
+	MediaType string
+	// Don't send a copy of the message to your own other devices. This only applies to 1:1 chats,
+	// and means the message won't appear in the chat list on your phone.
+	SkipOwnDevices bool
+	// Only send the message to these specific devices of the recipient instead of fetching the full device list.
+	// All the JIDs must be devices of the user the message is being sent to, on the same server (i.e. LID devices
+	// when sending to a LID). This only applies to 1:1 chats and implies SkipOwnDevices. It's mostly useful for debugging and resending messages to a single device.
+	TargetDevices []types.JID
+	// The disappearing message timer to put in the message's ContextInfo. This should match the chat's current timer,
+	// otherwise the message may not disappear on all devices. Must be one of the DisappearingTimer<Duration> constants.
+	//
+	// If not set, the expiration already in the message (e.g. copied by BuildReply) is kept. If the message doesn't
+	// have one either, the chat's current timer is used if the client has seen it (from a timer change, a group info
+	// fetch or SetDisappearingTimer). The message passed to SendMessage is never modified, the expiration is applied to a copy.
+	Expiration time.Duration
+	// The time when the disappearing timer of the chat was changed, sent along with Expiration.
+	EphemeralSettingTimestamp time.Time
+	// Metadata to put in the MessageContextInfo when sending a message as (or in reply to) a bot.
+	BotMetadata *waProto.BotMetadata
 }
 
 // SendMessage sends the given message.
@@ -174,15 +205,36 @@ func (cli *Client) SendMessage(ctx context.Context, to types.JID, message *waPro
 	if to.Device > 0 && !req.Peer {
 		err = ErrRecipientADJID
 		return
+	} else if len(req.TargetDevices) > 0 {
+		err = validateTargetDevices(to, req)
+		if err != nil {
+			return
+		}
+	}
+	if req.Expiration != 0 {
+		message = proto.Clone(message).(*waProto.Message)
+		err = applyExpiration(message, req.Expiration, req.EphemeralSettingTimestamp)
+		if err != nil {
+			return
+		}
+	} else if timer, ok := cli.getCachedDisappearingTimer(to); ok && !req.Peer && types.GetContextInfo(message).GetExpiration() == 0 {
+		withTimer := proto.Clone(message).(*waProto.Message)
+		// Messages that can't have a context info (e.g. reactions) are sent without the timer, like the official apps do.
+		if applyExpiration(withTimer, timer.Timer, timer.SettingTimestamp) == nil {
+			message = withTimer
+		}
 	}
 	ownID := cli.getOwnID()
 	if ownID.IsEmpty() {
 		err = ErrNotLoggedIn
 		return
 	}
+	if !req.Peer {
+		message = applyMessageContextInfo(message, req.BotMetadata)
+	}
 
 	if req.Timeout == 0 {
-		req.Timeout = defaultRequestTimeout
+		req.Timeout = cli.getMessageAckTimeout()
 	}
 	if len(req.ID) == 0 {
 		req.ID = cli.GenerateMessageID()
@@ -196,6 +248,16 @@ func (cli *Client) SendMessage(ctx context.Context, to types.JID, message *waPro
 		}
 	}
 	resp.ID = req.ID
+	if !req.Peer {
+		err = cli.runPreSendHooks(ctx, to, message)
+		if err != nil {
+			return
+		}
+		err = cli.waitSendRateLimit(ctx)
+		if err != nil {
+			return
+		}
+	}
 
 	start := time.Now()
 	// Sending multiple messages at a time can cause weird issues and makes it harder to retry safely
@@ -216,16 +278,19 @@ func (cli *Client) SendMessage(ctx context.Context, to types.JID, message *waPro
 			cli.Log.Debugf("Stored message secret key for outgoing message %s", req.ID)
 		}
 	}
+	if req.MediaType == "" {
+		req.MediaType = getMediaTypeFromMessage(message)
+	}
 	var phash string
 	var data []byte
 	switch to.Server {
 	case types.GroupServer, types.BroadcastServer:
-		phash, data, err = cli.sendGroup(ctx, to, ownID, req.ID, message, &resp.DebugTimings)
+		phash, data, err = cli.sendGroup(ctx, to, ownID, req.ID, message, req.MediaType, &resp.DebugTimings)
 	case types.DefaultUserServer:
 		if req.Peer {
 			data, err = cli.sendPeerMessage(to, req.ID, message, &resp.DebugTimings)
 		} else {
-			data, err = cli.sendDM(ctx, to, ownID, req.ID, message, &resp.DebugTimings)
+			data, err = cli.sendDM(ctx, to, ownID, req.ID, message, req.MediaType, req.SkipOwnDevices, req.TargetDevices, &resp.DebugTimings)
 		}
 	case types.NewsletterServer:
 		data, err = cli.sendNewsletter(to, req.ID, message, req.MediaHandle, &resp.DebugTimings)
@@ -248,7 +313,7 @@ func (cli *Client) SendMessage(ctx context.Context, to types.JID, message *waPro
 	case respNode = <-respChan:
 	case <-timeoutChan:
 		cli.cancelResponse(req.ID, respChan)
-		err = ErrMessageTimedOut
+		err = &NoAckError{MessageID: req.ID, Err: ErrMessageTimedOut}
 		return
 	case <-ctx.Done():
 		cli.cancelResponse(req.ID, respChan)
@@ -258,9 +323,15 @@ func (cli *Client) SendMessage(ctx context.Context, to types.JID, message *waPro
 	resp.DebugTimings.Resp = time.Since(start)
 	if isDisconnectNode(respNode) {
 		start = time.Now()
-		respNode, err = cli.retryFrame("message send", req.ID, data, respNode, ctx, 0)
+		respNode, err = cli.retryFrame("message send", req.ID, data, respNode, ctx, req.Timeout)
 		resp.DebugTimings.Retry = time.Since(start)
-		if err != nil {
+		if errors.Is(err, ErrIQTimedOut) {
+			err = &NoAckError{MessageID: req.ID, Err: ErrMessageTimedOut}
+			return
+		} else if err != nil && ctx.Err() == nil {
+			err = &NoAckError{MessageID: req.ID, Err: err}
+			return
+		} else if err != nil {
 			return
 		}
 	}
@@ -269,6 +340,11 @@ func (cli *Client) SendMessage(ctx context.Context, to types.JID, message *waPro
 	resp.Timestamp = ag.UnixTime("t")
 	if errorCode := ag.Int("error"); errorCode != 0 {
 		err = fmt.Errorf("%w %d", ErrServerReturnedError, errorCode)
+		if to.Server == types.NewsletterServer && (errorCode == 401 || errorCode == 403) {
+			err = fmt.Errorf("%w (%w)", ErrNotNewsletterAdmin, err)
+		}
+	} else {
+		cli.storeOutgoingPollMessage(to, ownID, resp, message)
 	}
 	expectedPHash := ag.OptionalString("phash")
 	if len(expectedPHash) > 0 && phash != expectedPHash {
@@ -391,12 +467,21 @@ func (cli *Client) BuildHistorySyncRequest(lastKnownMessageInfo *types.MessageIn
 // EditWindow specifies how long a message can be edited for after it was sent.
 const EditWindow = 20 * time.Minute
 
+// IsWithinEditWindow checks if a message sent at the given time can still be edited, i.e. if less than EditWindow
+// has passed since then according to the server's clock (see Client.ServerTime).
+func (cli *Client) IsWithinEditWindow(sentAt time.Time) bool {
+	return cli.ServerTime().Sub(sentAt) < EditWindow
+}
+
 // BuildEdit builds a message edit message using the given variables.
 // The built message can be sent normally using Client.SendMessage.
 //
 //	resp, err := cli.SendMessage(context.Background(), chat, cli.BuildEdit(chat, originalMessageID, &waProto.Message{
 //		Conversation: proto.String("edited message"),
 //	})
+//
+// Edits can also be sent to newsletters (channels) that you're an admin of. In that case, the ID must be the
+// message ID of the original post (not the server ID), and ErrNotNewsletterAdmin is returned if you're not an admin.
 func (cli *Client) BuildEdit(chat types.JID, id types.MessageID, newContent *waProto.Message) *waProto.Message {
 	return &waProto.Message{
 		EditedMessage: &waProto.FutureProofMessage{
@@ -409,7 +494,7 @@ func (cli *Client) BuildEdit(chat types.JID, id types.MessageID, newContent *waP
 					},
 					Type:          waProto.ProtocolMessage_MESSAGE_EDIT.Enum(),
 					EditedMessage: newContent,
-					TimestampMs:   proto.Int64(time.Now().UnixMilli()),
+					TimestampMs:   proto.Int64(cli.ServerTime().UnixMilli()),
 				},
 			},
 		},
@@ -456,6 +541,9 @@ func (cli *Client) SetDisappearingTimer(chat types.JID, timer time.Duration) (er
 				EphemeralExpiration: proto.Uint32(uint32(timer.Seconds())),
 			},
 		})
+		if err == nil {
+			cli.cacheDisappearingTimer(chat, timer, time.Now())
+		}
 	case types.GroupServer:
 		if timer == 0 {
 			_, err = cli.sendGroupIQ(context.TODO(), iqSet, chat, waBinary.Node{Tag: "not_ephemeral"})
@@ -476,6 +564,87 @@ func (cli *Client) SetDisappearingTimer(chat types.JID, timer time.Duration) (er
 	return
 }
 
+func isAllowedDisappearingTimer(timer time.Duration) bool {
+	switch timer {
+	case DisappearingTimer24Hours, DisappearingTimer7Days, DisappearingTimer90Days:
+		return true
+	default:
+		return false
+	}
+}
+
+func applyExpiration(message *waProto.Message, timer time.Duration, settingTS time.Time) error {
+	if !isAllowedDisappearingTimer(timer) {
+		return fmt.Errorf("%w: %s", ErrInvalidDisappearingTimer, timer)
+	}
+	if message.Conversation != nil {
+		message.ExtendedTextMessage = &waProto.ExtendedTextMessage{Text: message.Conversation}
+		message.Conversation = nil
+	}
+	ctxInfo := types.GetMutableContextInfo(message)
+	if ctxInfo == nil {
+		return fmt.Errorf("can't set expiration: message type doesn't support context info")
+	}
+	ctxInfo.Expiration = proto.Uint32(uint32(timer.Seconds()))
+	if !settingTS.IsZero() {
+		ctxInfo.EphemeralSettingTimestamp = proto.Int64(settingTS.Unix())
+	}
+	return nil
+}
+
+type cachedDisappearingTimer struct {
+	Timer            time.Duration
+	SettingTimestamp time.Time
+}
+
+// cacheDisappearingTimer remembers the current disappearing timer of a chat, so that SendMessage can apply it by default.
+func (cli *Client) cacheDisappearingTimer(chat types.JID, timer time.Duration, settingTS time.Time) {
+	cli.disappearingTimerCacheLock.Lock()
+	defer cli.disappearingTimerCacheLock.Unlock()
+	if timer == 0 {
+		delete(cli.disappearingTimerCache, chat.ToNonAD())
+	} else {
+		cli.disappearingTimerCache[chat.ToNonAD()] = cachedDisappearingTimer{Timer: timer, SettingTimestamp: settingTS}
+	}
+}
+
+func (cli *Client) getCachedDisappearingTimer(chat types.JID) (cachedDisappearingTimer, bool) {
+	cli.disappearingTimerCacheLock.Lock()
+	defer cli.disappearingTimerCacheLock.Unlock()
+	timer, ok := cli.disappearingTimerCache[chat.ToNonAD()]
+	return timer, ok
+}
+
+// messageNeedsSecret returns true if the server expects the message to include a MessageContextInfo.MessageSecret,
+// which other users will use to encrypt things that refer to the message (e.g. poll votes).
+func messageNeedsSecret(message *waProto.Message) bool {
+	return message.PollCreationMessage != nil ||
+		message.PollCreationMessageV2 != nil ||
+		message.PollCreationMessageV3 != nil ||
+		message.ReactionMessage != nil
+}
+
+// applyMessageContextInfo returns a copy of the message with the message secret and bot metadata filled in.
+// If neither needs to be added, the message is returned as-is. The secret is stored by SendMessage,
+// so callers don't need to get it from the message.
+func applyMessageContextInfo(message *waProto.Message, botMetadata *waProto.BotMetadata) *waProto.Message {
+	needsSecret := messageNeedsSecret(message) && len(message.GetMessageContextInfo().GetMessageSecret()) == 0
+	if !needsSecret && botMetadata == nil {
+		return message
+	}
+	message = proto.Clone(message).(*waProto.Message)
+	if message.MessageContextInfo == nil {
+		message.MessageContextInfo = &waProto.MessageContextInfo{}
+	}
+	if needsSecret {
+		message.MessageContextInfo.MessageSecret = random.Bytes(32)
+	}
+	if botMetadata != nil {
+		message.MessageContextInfo.BotMetadata = botMetadata
+	}
+	return message
+}
+
 func participantListHashV2(participants []types.JID) string {
 	participantsStrings := make([]string, len(participants))
 	for i, part := range participants {
@@ -531,7 +700,7 @@ func (cli *Client) sendNewsletter(to types.JID, id types.MessageID, message *waP
 	return data, nil
 }
 
-func (cli *Client) sendGroup(ctx context.Context, to, ownID types.JID, id types.MessageID, message *waProto.Message, timings *MessageDebugTimings) (string, []byte, error) {
+func (cli *Client) sendGroup(ctx context.Context, to, ownID types.JID, id types.MessageID, message *waProto.Message, mediaType string, timings *MessageDebugTimings) (string, []byte, error) {
 	var participants []types.JID
 	var err error
 	start := time.Now()
@@ -548,6 +717,10 @@ func (cli *Client) sendGroup(ctx context.Context, to, ownID types.JID, id types.
 		}
 	}
 	timings.GetParticipants = time.Since(start)
+	senderID, err := cli.getGroupSenderID(to, ownID)
+	if err != nil {
+		return "", nil, err
+	}
 	start = time.Now()
 	plaintext, _, err := marshalMessage(to, message)
 	timings.Marshal = time.Since(start)
@@ -556,8 +729,9 @@ func (cli *Client) sendGroup(ctx context.Context, to, ownID types.JID, id types.
 	}
 
 	start = time.Now()
+	senderKeyShared := cli.getSenderKeyShared(to, senderID)
 	builder := groups.NewGroupSessionBuilder(cli.Store, pbSerializer)
-	senderKeyName := protocol.NewSenderKeyName(to.String(), ownID.SignalAddress())
+	senderKeyName := protocol.NewSenderKeyName(to.String(), senderID.SignalAddress())
 	signalSKDMessage, err := builder.Create(senderKeyName)
 	if err != nil {
 		return "", nil, fmt.Errorf("failed to create sender key distribution message to send %s to %s: %w", id, to, err)
@@ -581,19 +755,22 @@ func (cli *Client) sendGroup(ctx context.Context, to, ownID types.JID, id types.
 	ciphertext := encrypted.SignedSerialize()
 	timings.GroupEncrypt = time.Since(start)
 
-	node, allDevices, err := cli.prepareMessageNode(ctx, to, ownID, id, message, participants, skdPlaintext, nil, timings)
+	node, allDevices, err := cli.prepareMessageNode(ctx, to, ownID, id, message, mediaType, participants, false, skdPlaintext, nil, senderKeyShared, timings)
 	if err != nil {
 		return "", nil, err
 	}
 
 	phash := participantListHashV2(allDevices)
 	node.Attrs["phash"] = phash
+	if senderID.Server == types.HiddenUserServer {
+		node.Attrs["addressing_mode"] = string(types.AddressingModeLID)
+	}
 	skMsg := waBinary.Node{
 		Tag:     "enc",
 		Content: ciphertext,
 		Attrs:   waBinary.Attrs{"v": "2", "type": "skmsg"},
 	}
-	if mediaType := getMediaTypeFromMessage(message); mediaType != "" {
+	if mediaType != "" {
 		skMsg.Attrs["mediatype"] = mediaType
 	}
 	node.Content = append(node.GetChildren(), skMsg)
@@ -604,6 +781,7 @@ func (cli *Client) sendGroup(ctx context.Context, to, ownID types.JID, id types.
 	if err != nil {
 		return "", nil, fmt.Errorf("failed to send message node: %w", err)
 	}
+	cli.markSenderKeyShared(to, node)
 	return phash, data, nil
 }
 
@@ -621,7 +799,26 @@ func (cli *Client) sendPeerMessage(to types.JID, id types.MessageID, message *wa
 	return data, nil
 }
 
-func (cli *Client) sendDM(ctx context.Context, to, ownID types.JID, id types.MessageID, message *waProto.Message, timings *MessageDebugTimings) ([]byte, error) {
+func (cli *Client) getMessageAckTimeout() time.Duration {
+	if cli.MessageAckTimeout == 0 {
+		return defaultRequestTimeout
+	}
+	return cli.MessageAckTimeout
+}
+
+func validateTargetDevices(to types.JID, req SendRequestExtra) error {
+	if (to.Server != types.DefaultUserServer && to.Server != types.HiddenUserServer) || req.Peer {
+		return fmt.Errorf("%w: target devices can only be specified for 1:1 chats", ErrInvalidTargetDevice)
+	}
+	for _, device := range req.TargetDevices {
+		if device.Server != to.Server || device.User != to.User {
+			return fmt.Errorf("%w: %s is not a device of %s", ErrInvalidTargetDevice, device, to)
+		}
+	}
+	return nil
+}
+
+func (cli *Client) sendDM(ctx context.Context, to, ownID types.JID, id types.MessageID, message *waProto.Message, mediaType string, skipOwnDevices bool, targetDevices []types.JID, timings *MessageDebugTimings) ([]byte, error) {
 	start := time.Now()
 	messagePlaintext, deviceSentMessagePlaintext, err := marshalMessage(to, message)
 	timings.Marshal = time.Since(start)
@@ -629,7 +826,14 @@ func (cli *Client) sendDM(ctx context.Context, to, ownID types.JID, id types.Mes
 		return nil, err
 	}
 
-	node, _, err := cli.prepareMessageNode(ctx, to, ownID, id, message, []types.JID{to, ownID.ToNonAD()}, messagePlaintext, deviceSentMessagePlaintext, timings)
+	participants := []types.JID{to, ownID.ToNonAD()}
+	if skipOwnDevices {
+		participants = participants[:1]
+	}
+	if len(targetDevices) > 0 {
+		participants = targetDevices
+	}
+	node, _, err := cli.prepareMessageNode(ctx, to, ownID, id, message, mediaType, participants, len(targetDevices) > 0, messagePlaintext, deviceSentMessagePlaintext, nil, timings)
 	if err != nil {
 		return nil, err
 	}
@@ -656,7 +860,7 @@ func getTypeFromMessage(msg *waProto.Message) string {
 		return "reaction"
 	case msg.PollCreationMessage != nil, msg.PollUpdateMessage != nil:
 		return "poll"
-	case getMediaTypeFromMessage(msg) != "":
+	case msg.AlbumMessage != nil, getMediaTypeFromMessage(msg) != "":
 		return "media"
 	case msg.Conversation != nil, msg.ExtendedTextMessage != nil, msg.ProtocolMessage != nil:
 		return "text"
@@ -821,7 +1025,11 @@ func (cli *Client) preparePeerMessageNode(to types.JID, id types.MessageID, mess
 }
 
 func (cli *Client) getMessageContent(baseNode waBinary.Node, message *waProto.Message, msgAttrs waBinary.Attrs, includeIdentity bool) []waBinary.Node {
-	content := []waBinary.Node{baseNode}
+	var content []waBinary.Node
+	// Group messages don't have a participants node if all devices already have the sender key
+	if baseNode.Tag != "participants" || len(baseNode.GetChildren()) > 0 {
+		content = append(content, baseNode)
+	}
 	if includeIdentity {
 		content = append(content, cli.makeDeviceIdentityNode())
 	}
@@ -849,19 +1057,25 @@ func (cli *Client) getMessageContent(baseNode waBinary.Node, message *waProto.Me
 	return content
 }
 
-func (cli *Client) prepareMessageNode(ctx context.Context, to, ownID types.JID, id types.MessageID, message *waProto.Message, participants []types.JID, plaintext, dsmPlaintext []byte, timings *MessageDebugTimings) (*waBinary.Node, []types.JID, error) {
+// prepareMessageNode encrypts the message for all devices of the given participants. If participantsAreDevices is true,
+// the participants are used as-is instead of fetching their device lists.
+func (cli *Client) prepareMessageNode(ctx context.Context, to, ownID types.JID, id types.MessageID, message *waProto.Message, mediaType string, participants []types.JID, participantsAreDevices bool, plaintext, dsmPlaintext []byte, skipAddresses map[string]struct{}, timings *MessageDebugTimings) (*waBinary.Node, []types.JID, error) {
 	start := time.Now()
-	allDevices, err := cli.GetUserDevicesContext(ctx, participants)
-	timings.GetDevices = time.Since(start)
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to get device list: %w", err)
+	allDevices := participants
+	if !participantsAreDevices {
+		var err error
+		allDevices, err = cli.GetUserDevicesContext(ctx, participants)
+		timings.GetDevices = time.Since(start)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get device list: %w", err)
+		}
 	}
 
 	msgType := getTypeFromMessage(message)
 	encAttrs := waBinary.Attrs{}
 	// Only include encMediaType for 1:1 messages (groups don't have a device-sent message plaintext)
-	if encMediaType := getMediaTypeFromMessage(message); dsmPlaintext != nil && encMediaType != "" {
-		encAttrs["mediatype"] = encMediaType
+	if dsmPlaintext != nil && mediaType != "" {
+		encAttrs["mediatype"] = mediaType
 	}
 	attrs := waBinary.Attrs{
 		"id":   id,
@@ -876,8 +1090,18 @@ func (cli *Client) prepareMessageNode(ctx context.Context, to, ownID types.JID,
 		encAttrs["decrypt-fail"] = string(events.DecryptFailHide)
 	}
 
+	encryptDevices := allDevices
+	if len(skipAddresses) > 0 {
+		encryptDevices = make([]types.JID, 0, len(allDevices))
+		for _, jid := range allDevices {
+			if _, skip := skipAddresses[jid.SignalAddressString()]; !skip {
+				encryptDevices = append(encryptDevices, jid)
+			}
+		}
+	}
+
 	start = time.Now()
-	participantNodes, includeIdentity := cli.encryptMessageForDevices(ctx, allDevices, ownID, id, plaintext, dsmPlaintext, encAttrs)
+	participantNodes, includeIdentity := cli.encryptMessageForDevices(ctx, encryptDevices, ownID, id, plaintext, dsmPlaintext, encAttrs)
 	timings.PeerEncrypt = time.Since(start)
 	participantNode := waBinary.Node{
 		Tag:     "participants",
@@ -1005,9 +1229,7 @@ func (cli *Client) encryptMessageForDevice(plaintext []byte, to types.JID, bundl
 	if bundle != nil {
 		cli.Log.Debugf("Processing prekey bundle for %s", to)
 		err := builder.ProcessBundle(bundle)
-		if cli.AutoTrustIdentity && errors.Is(err, signalerror.ErrUntrustedIdentity) {
-			cli.Log.Warnf("Got %v error while trying to process prekey bundle for %s, clearing stored identity and retrying", err, to)
-			cli.clearUntrustedIdentity(to)
+		if errors.Is(err, signalerror.ErrUntrustedIdentity) && cli.handleUntrustedIdentity(to, bundle.IdentityKey().PublicKey().PublicKey()) {
 			err = builder.ProcessBundle(bundle)
 		}
 		if err != nil {
@@ -1038,3 +1260,91 @@ func (cli *Client) encryptMessageForDevice(plaintext []byte, to types.JID, bundl
 		Content: ciphertext.Serialize(),
 	}, includeDeviceIdentity, nil
 }
+
+// getGroupSenderID returns the JID that our sender key in the given group is bound to.
+// In LID-addressed groups, the sender key must be bound to our LID instead of our phone number.
+func (cli *Client) getGroupSenderID(group, ownID types.JID) (types.JID, error) {
+	if group.Server != types.GroupServer || cli.getCachedGroupAddressingMode(group) != types.AddressingModeLID {
+		return ownID, nil
+	} else if cli.Store.LID.IsEmpty() {
+		return types.EmptyJID, fmt.Errorf("can't send to LID-addressed group %s: own LID is not known", group)
+	}
+	return types.JID{User: cli.Store.LID.User, Device: ownID.Device, Server: types.HiddenUserServer}, nil
+}
+
+// getSenderKeyShared returns the addresses of devices that already have our current sender key for the given group,
+// so that the sender key distribution message doesn't have to be sent to them again.
+func (cli *Client) getSenderKeyShared(group, ownID types.JID) map[string]struct{} {
+	existingKey, err := cli.Store.SenderKeys.GetSenderKey(group.String(), ownID.SignalAddressString())
+	if err != nil {
+		cli.Log.Warnf("Failed to check if sender key for %s exists: %v", group, err)
+		return nil
+	} else if existingKey == nil {
+		// A new sender key will be created, so nobody has it yet
+		err = cli.Store.SenderKeys.ResetSenderKeyShared(group.String())
+		if err != nil {
+			cli.Log.Warnf("Failed to reset sender key recipients in %s: %v", group, err)
+		}
+		return nil
+	}
+	shared, err := cli.Store.SenderKeys.GetSenderKeySharedWith(group.String())
+	if err != nil {
+		cli.Log.Warnf("Failed to get sender key recipients in %s: %v", group, err)
+		return nil
+	}
+	return shared
+}
+
+// markSenderKeyShared stores that the devices in the participants node of the given message node have received our sender key.
+func (cli *Client) markSenderKeyShared(group types.JID, node *waBinary.Node) {
+	participantsNode, ok := node.GetOptionalChildByTag("participants")
+	if !ok {
+		return
+	}
+	addresses := make([]string, 0, len(participantsNode.GetChildren()))
+	for _, child := range participantsNode.GetChildren() {
+		if jid, ok := child.Attrs["jid"].(types.JID); ok {
+			addresses = append(addresses, jid.SignalAddressString())
+		}
+	}
+	err := cli.Store.SenderKeys.MarkSenderKeyShared(group.String(), addresses)
+	if err != nil {
+		cli.Log.Warnf("Failed to store sender key recipients in %s: %v", group, err)
+	}
+}
+
+// RotateSenderKey deletes our current sender key for the given group, so that a new one is generated and
+// distributed to all participants with the next message sent to the group.
+//
+// This prevents users who have left the group from decrypting future messages, even if they still somehow
+// receive them. It can be done automatically whenever someone leaves a group by setting Client.RotateSenderKeyOnLeave.
+func (cli *Client) RotateSenderKey(group types.JID) error {
+	ownID := cli.getOwnID()
+	if ownID.IsEmpty() {
+		return ErrNotLoggedIn
+	}
+	senderID, err := cli.getGroupSenderID(group, ownID)
+	if err != nil {
+		return err
+	}
+	cli.messageSendLock.Lock()
+	defer cli.messageSendLock.Unlock()
+	err = cli.Store.SenderKeys.DeleteSenderKey(group.String(), senderID.SignalAddressString())
+	if err != nil {
+		return fmt.Errorf("failed to delete sender key: %w", err)
+	}
+	err = cli.Store.SenderKeys.ResetSenderKeyShared(group.String())
+	if err != nil {
+		return fmt.Errorf("failed to reset sender key recipients: %w", err)
+	}
+	return nil
+}
+
+// ForceSenderKeyRedistribution makes the next message sent to the given group include our sender key
+// for all participant devices, instead of only the devices that haven't received it yet.
+//
+// This is not needed in normal operation, as devices that can't decrypt a message will send a retry receipt,
+// which is answered with the sender key automatically.
+func (cli *Client) ForceSenderKeyRedistribution(group types.JID) error {
+	return cli.Store.SenderKeys.ResetSenderKeyShared(group.String())
+}
@@ -96,7 +96,7 @@ func (cli *Client) SendFBMessage(
 	}
 
 	if req.Timeout == 0 {
-		req.Timeout = defaultRequestTimeout
+		req.Timeout = cli.getMessageAckTimeout()
 	}
 	if len(req.ID) == 0 {
 		req.ID = cli.GenerateMessageID()
@@ -144,7 +144,7 @@ func (cli *Client) SendFBMessage(
 	case respNode = <-respChan:
 	case <-timeoutChan:
 		cli.cancelResponse(req.ID, respChan)
-		err = ErrMessageTimedOut
+		err = &NoAckError{MessageID: req.ID, Err: ErrMessageTimedOut}
 		return
 	case <-ctx.Done():
 		cli.cancelResponse(req.ID, respChan)
@@ -154,9 +154,15 @@ func (cli *Client) SendFBMessage(
 	resp.DebugTimings.Resp = time.Since(start)
 	if isDisconnectNode(respNode) {
 		start = time.Now()
-		respNode, err = cli.retryFrame("message send", req.ID, data, respNode, ctx, 0)
+		respNode, err = cli.retryFrame("message send", req.ID, data, respNode, ctx, req.Timeout)
 		resp.DebugTimings.Retry = time.Since(start)
-		if err != nil {
+		if errors.Is(err, ErrIQTimedOut) {
+			err = &NoAckError{MessageID: req.ID, Err: ErrMessageTimedOut}
+			return
+		} else if err != nil && ctx.Err() == nil {
+			err = &NoAckError{MessageID: req.ID, Err: err}
+			return
+		} else if err != nil {
 			return
 		}
 	}
@@ -554,9 +560,7 @@ func (cli *Client) encryptMessageForDeviceV3(
 	if bundle != nil {
 		cli.Log.Debugf("Processing prekey bundle for %s", to)
 		err := builder.ProcessBundle(bundle)
-		if cli.AutoTrustIdentity && errors.Is(err, signalerror.ErrUntrustedIdentity) {
-			cli.Log.Warnf("Got %v error while trying to process prekey bundle for %s, clearing stored identity and retrying", err, to)
-			cli.clearUntrustedIdentity(to)
+		if errors.Is(err, signalerror.ErrUntrustedIdentity) && cli.handleUntrustedIdentity(to, bundle.IdentityKey().PublicKey().PublicKey()) {
 			err = builder.ProcessBundle(bundle)
 		}
 		if err != nil {
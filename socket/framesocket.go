@@ -22,6 +22,24 @@ import (
 
 type Proxy = func(*http.Request) (*url.URL, error)
 
+// websocketProxy wraps the given proxy function so that socks5h:// URLs also work for the websocket dialer.
+// The websocket library only knows the socks5 scheme, but its dialer always lets the proxy resolve hostnames,
+// which is what socks5h means anyway.
+func websocketProxy(proxy Proxy) Proxy {
+	if proxy == nil {
+		return nil
+	}
+	return func(req *http.Request) (*url.URL, error) {
+		proxyURL, err := proxy(req)
+		if err == nil && proxyURL != nil && proxyURL.Scheme == "socks5h" {
+			clone := *proxyURL
+			clone.Scheme = "socks5"
+			proxyURL = &clone
+		}
+		return proxyURL, err
+	}
+}
+
 type FrameSocket struct {
 	conn   *websocket.Conn
 	ctx    context.Context
@@ -97,6 +115,11 @@ func (fs *FrameSocket) Close(code int) {
 }
 
 func (fs *FrameSocket) Connect() error {
+	return fs.ConnectContext(context.Background())
+}
+
+// ConnectContext dials the websocket. The context is only used for the dial, canceling it afterwards won't close the socket.
+func (fs *FrameSocket) ConnectContext(dialCtx context.Context) error {
 	fs.lock.Lock()
 	defer fs.lock.Unlock()
 
@@ -105,11 +128,11 @@ func (fs *FrameSocket) Connect() error {
 	}
 	ctx, cancel := context.WithCancel(context.Background())
 	dialer := websocket.Dialer{
-		Proxy: fs.Proxy,
+		Proxy: websocketProxy(fs.Proxy),
 	}
 
 	fs.log.Debugf("Dialing %s", fs.URL)
-	conn, _, err := dialer.Dial(fs.URL, fs.HTTPHeaders)
+	conn, _, err := dialer.DialContext(dialCtx, fs.URL, fs.HTTPHeaders)
 	if err != nil {
 		cancel()
 		return fmt.Errorf("couldn't dial whatsapp web websocket: %w", err)
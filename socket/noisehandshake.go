@@ -75,12 +75,8 @@ func (nh *NoiseHandshake) Decrypt(ciphertext []byte) (plaintext []byte, err erro
 }
 
 func (nh *NoiseHandshake) Finish(fs *FrameSocket, frameHandler FrameHandler, disconnectHandler DisconnectHandler) (*NoiseSocket, error) {
-	if write, read, err := nh.extractAndExpand(nh.salt, nil); err != nil {
-		return nil, fmt.Errorf("failed to extract final keys: %w", err)
-	} else if writeKey, err := gcmutil.Prepare(write); err != nil {
-		return nil, fmt.Errorf("failed to create final write cipher: %w", err)
-	} else if readKey, err := gcmutil.Prepare(read); err != nil {
-		return nil, fmt.Errorf("failed to create final read cipher: %w", err)
+	if writeKey, readKey, err := nh.FinishKeys(); err != nil {
+		return nil, err
 	} else if ns, err := newNoiseSocket(fs, writeKey, readKey, frameHandler, disconnectHandler); err != nil {
 		return nil, fmt.Errorf("failed to create noise socket: %w", err)
 	} else {
@@ -88,6 +84,20 @@ func (nh *NoiseHandshake) Finish(fs *FrameSocket, frameHandler FrameHandler, dis
 	}
 }
 
+// FinishKeys extracts the final transport keys of the handshake without creating a NoiseSocket.
+// The keys are from the point of view of the client, so a server must use the write key for reading and vice versa.
+func (nh *NoiseHandshake) FinishKeys() (writeKey, readKey cipher.AEAD, err error) {
+	write, read, err := nh.extractAndExpand(nh.salt, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to extract final keys: %w", err)
+	} else if writeKey, err = gcmutil.Prepare(write); err != nil {
+		return nil, nil, fmt.Errorf("failed to create final write cipher: %w", err)
+	} else if readKey, err = gcmutil.Prepare(read); err != nil {
+		return nil, nil, fmt.Errorf("failed to create final read cipher: %w", err)
+	}
+	return
+}
+
 func (nh *NoiseHandshake) MixSharedSecretIntoKey(priv, pub [32]byte) error {
 	secret, err := curve25519.X25519(priv[:], pub[:])
 	if err != nil {
@@ -0,0 +1,34 @@
+// Copyright (c) 2022 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"go.mau.fi/whatsmeow/appstate"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+)
+
+// StarMessage stars or unstars the given message. The change is synced to the user's other devices using app state,
+// and an events.Star is dispatched for it like for stars from other devices.
+//
+// The sender is only needed in group chats for messages sent by other users.
+func (cli *Client) StarMessage(chat, sender types.JID, id types.MessageID, fromMe, starred bool) error {
+	return cli.SendAppState(appstate.BuildStar(chat, sender, id, fromMe, starred))
+}
+
+// GetStarredMessages returns the keys of all messages that the user has starred, newest first.
+//
+// Stars are synced using app state, so this only contains the stars that have been received through
+// app state syncing (or set with StarMessage). The message contents are not stored.
+func (cli *Client) GetStarredMessages() ([]store.StarredMessage, error) {
+	return cli.Store.Stars.GetStarredMessages()
+}
+
+// IsMessageStarred checks whether the given message has been starred by the user.
+func (cli *Client) IsMessageStarred(chat types.JID, id types.MessageID) (bool, error) {
+	return cli.Store.Stars.IsMessageStarred(chat, id)
+}
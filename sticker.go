@@ -0,0 +1,124 @@
+// Copyright (c) 2022 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+)
+
+// WebPMimetype is the mimetype of sticker messages.
+const WebPMimetype = "image/webp"
+
+type webpInfo struct {
+	Width    uint32
+	Height   uint32
+	Animated bool
+}
+
+// parseWebP reads the dimensions of a WebP image and checks whether it's animated.
+//
+// Only the chunk headers are parsed, the image data itself isn't validated.
+func parseWebP(data []byte) (*webpInfo, error) {
+	if len(data) < 12 || !bytes.Equal(data[:4], []byte("RIFF")) || !bytes.Equal(data[8:12], []byte("WEBP")) {
+		return nil, ErrInvalidWebP
+	}
+	var info webpInfo
+	data = data[12:]
+	for len(data) >= 8 {
+		chunkType := string(data[:4])
+		chunkSize := binary.LittleEndian.Uint32(data[4:8])
+		if uint64(chunkSize) > uint64(len(data)-8) {
+			return nil, ErrInvalidWebP
+		}
+		chunk := data[8 : 8+chunkSize]
+		switch chunkType {
+		case "VP8X":
+			// Extended format: the canvas size is always here, and the flags say if there's an animation
+			if len(chunk) < 10 {
+				return nil, ErrInvalidWebP
+			}
+			info.Animated = chunk[0]&0x02 != 0
+			info.Width = (uint32(chunk[4]) | uint32(chunk[5])<<8 | uint32(chunk[6])<<16) + 1
+			info.Height = (uint32(chunk[7]) | uint32(chunk[8])<<8 | uint32(chunk[9])<<16) + 1
+			return &info, nil
+		case "VP8 ":
+			// Lossy: 3 byte frame tag, 3 byte start code, then 14-bit width and height
+			if len(chunk) < 10 || !bytes.Equal(chunk[3:6], []byte{0x9d, 0x01, 0x2a}) {
+				return nil, ErrInvalidWebP
+			}
+			info.Width = uint32(binary.LittleEndian.Uint16(chunk[6:8]) & 0x3fff)
+			info.Height = uint32(binary.LittleEndian.Uint16(chunk[8:10]) & 0x3fff)
+			return &info, nil
+		case "VP8L":
+			// Lossless: 1 byte signature, then 14-bit width-1 and height-1
+			if len(chunk) < 5 || chunk[0] != 0x2f {
+				return nil, ErrInvalidWebP
+			}
+			bits := binary.LittleEndian.Uint32(chunk[1:5])
+			info.Width = bits&0x3fff + 1
+			info.Height = (bits>>14)&0x3fff + 1
+			return &info, nil
+		}
+		// Chunks are padded to an even size
+		chunkSize += chunkSize & 1
+		if uint64(chunkSize) > uint64(len(data)-8) {
+			break
+		}
+		data = data[8+chunkSize:]
+	}
+	return nil, ErrInvalidWebP
+}
+
+// BuildSticker uploads the given WebP image and returns a StickerMessage that can be sent with Client.SendMessage.
+//
+// The dimensions are read from the image. IsAnimated is set if animated is true or if the image contains an
+// animation, as animated stickers are rendered as static images without the flag.
+//
+// ErrInvalidWebP is returned if the data isn't a WebP image.
+func (cli *Client) BuildSticker(ctx context.Context, webpData []byte, animated bool) (*waProto.StickerMessage, error) {
+	info, err := parseWebP(webpData)
+	if err != nil {
+		return nil, err
+	}
+	uploaded, err := cli.Upload(ctx, webpData, MediaImage)
+	if err != nil {
+		return nil, err
+	}
+	return &waProto.StickerMessage{
+		Url:               proto.String(uploaded.URL),
+		DirectPath:        proto.String(uploaded.DirectPath),
+		MediaKey:          uploaded.MediaKey,
+		FileEncSha256:     uploaded.FileEncSHA256,
+		FileSha256:        uploaded.FileSHA256,
+		FileLength:        proto.Uint64(uploaded.FileLength),
+		Mimetype:          proto.String(WebPMimetype),
+		Width:             proto.Uint32(info.Width),
+		Height:            proto.Uint32(info.Height),
+		IsAnimated:        proto.Bool(animated || info.Animated),
+		MediaKeyTimestamp: proto.Int64(time.Now().Unix()),
+	}, nil
+}
+
+// SendSticker uploads the given WebP image and sends it as a sticker message.
+//
+// This is a shorthand for BuildSticker and SendMessage, see BuildSticker for details.
+// Received stickers can be downloaded with Client.Download like other media messages.
+func (cli *Client) SendSticker(ctx context.Context, chat types.JID, webpData []byte, animated bool, extra ...SendRequestExtra) (resp SendResponse, err error) {
+	stickerMsg, err := cli.BuildSticker(ctx, webpData, animated)
+	if err != nil {
+		return
+	}
+	return cli.SendMessage(ctx, chat, &waProto.Message{StickerMessage: stickerMsg}, extra...)
+}
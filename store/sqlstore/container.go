@@ -7,10 +7,13 @@
 package sqlstore
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
 	mathRand "math/rand"
+	"sync"
+	"time"
 
 	"github.com/google/uuid"
 	"go.mau.fi/util/random"
@@ -23,12 +26,50 @@ import (
 )
 
 // Container is a wrapper for a SQL database that can contain multiple whatsmeow sessions.
+//
+// A single Container can safely be shared by any number of clients running concurrently. The configuration fields
+// must be set before loading devices and must not be changed afterwards. All mutable state (e.g. locks and caches)
+// is per device, and loading the same device multiple times from one Container reuses the same underlying SQLStore,
+// so that the per-device locks and caches work even if the device is loaded again (e.g. after reconnecting).
 type Container struct {
 	db      *sql.DB
 	dialect string
 	log     waLog.Logger
 
+	stores     map[string]*SQLStore
+	storesLock sync.Mutex
+
 	DatabaseErrorHandler func(device *store.Device, action string, attemptIndex int, err error) (retry bool)
+
+	// MutationBatchSize is the maximum number of app state mutation MACs to insert in a single query.
+	// If zero, the batch size is calculated from the query parameter limit of the database dialect.
+	MutationBatchSize int
+	// CommitMutationBatchesSeparately makes PutAppStateMutationMACs commit each batch in its own transaction
+	// instead of wrapping all batches in one big transaction. This avoids holding locks for a long time during
+	// huge initial syncs, but a failure in the middle may leave some of the MACs stored.
+	CommitMutationBatchesSeparately bool
+
+	// RejectUnknownIdentities disables trust-on-first-use for Signal identity keys. When enabled, identities that
+	// haven't been stored before are not trusted, so encrypting or decrypting with a new device fails until the
+	// identity is explicitly trusted (see whatsmeow.Client.TrustIdentity). The client emits events.UnknownIdentity
+	// when this happens.
+	RejectUnknownIdentities bool
+
+	// SessionCacheSize enables an in-memory LRU cache of the given size for Signal sessions and identity keys,
+	// which are otherwise read from the database for every encrypted message. The cache is write-through, so it's
+	// always up to date as long as this container is the only writer. If other processes write to the same database,
+	// set SessionCacheTTL to bound how long stale entries may be used. Must be set before loading devices.
+	SessionCacheSize int
+	// SessionCacheTTL is the maximum age of entries in the session cache. If zero, entries only expire when evicted.
+	SessionCacheTTL time.Duration
+
+	// BeforeSessionWrite is called before a Signal session is overwritten, with the currently stored session
+	// (nil if there isn't one) and the new session. It's meant for debugging session corruption, e.g. logging
+	// session churn or detecting when a session would be replaced with an older one. If it returns an error,
+	// the session isn't stored and the error is returned from PutSession.
+	//
+	// Setting this makes every session write also read the existing session from the database (or the session cache).
+	BeforeSessionWrite func(ourJID, address string, existing, updated []byte) error
 }
 
 var _ store.DeviceContainer = (*Container)(nil)
@@ -81,19 +122,48 @@ func NewWithDB(db *sql.DB, dialect string, log waLog.Logger) *Container {
 		db:      db,
 		dialect: dialect,
 		log:     log,
+		stores:  make(map[string]*SQLStore),
 	}
 }
 
+// Ping verifies that the database connection is still alive, establishing a new connection if necessary.
+// It's meant to be used for liveness probes, as it doesn't query any whatsmeow tables.
+func (c *Container) Ping(ctx context.Context) error {
+	return c.db.PingContext(ctx)
+}
+
+// Health pings the database like Ping and returns the statistics of the connection pool
+// (e.g. the number of open, idle and in-use connections).
+func (c *Container) Health(ctx context.Context) (sql.DBStats, error) {
+	err := c.db.PingContext(ctx)
+	return c.db.Stats(), err
+}
+
 const getAllDevicesQuery = `
 SELECT jid, registration_id, noise_key, identity_key,
        signed_pre_key, signed_pre_key_id, signed_pre_key_sig,
        adv_key, adv_details, adv_account_sig, adv_account_sig_key, adv_device_sig,
-       platform, business_name, push_name, facebook_uuid
+       platform, business_name, push_name, facebook_uuid, default_disappearing_timer, lid
 FROM whatsmeow_device
 `
 
 const getDeviceQuery = getAllDevicesQuery + " WHERE jid=$1"
 
+// getStore returns the SQLStore of the given device, creating it if the device hasn't been loaded before.
+func (c *Container) getStore(jid types.JID) *SQLStore {
+	c.storesLock.Lock()
+	defer c.storesLock.Unlock()
+	if c.stores == nil {
+		c.stores = make(map[string]*SQLStore)
+	}
+	innerStore, ok := c.stores[jid.String()]
+	if !ok {
+		innerStore = NewSQLStore(c, jid)
+		c.stores[jid.String()] = innerStore
+	}
+	return innerStore
+}
+
 type scannable interface {
 	Scan(dest ...interface{}) error
 }
@@ -106,12 +176,13 @@ func (c *Container) scanDevice(row scannable) (*store.Device, error) {
 	var noisePriv, identityPriv, preKeyPriv, preKeySig []byte
 	var account waProto.ADVSignedDeviceIdentity
 	var fbUUID uuid.NullUUID
+	var disappearingTimer int64
 
 	err := row.Scan(
 		&device.ID, &device.RegistrationID, &noisePriv, &identityPriv,
 		&preKeyPriv, &device.SignedPreKey.KeyID, &preKeySig,
 		&device.AdvSecretKey, &account.Details, &account.AccountSignature, &account.AccountSignatureKey, &account.DeviceSignature,
-		&device.Platform, &device.BusinessName, &device.PushName, &fbUUID)
+		&device.Platform, &device.BusinessName, &device.PushName, &fbUUID, &disappearingTimer, &device.LID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to scan session: %w", err)
 	} else if len(noisePriv) != 32 || len(identityPriv) != 32 || len(preKeyPriv) != 32 || len(preKeySig) != 64 {
@@ -124,8 +195,9 @@ func (c *Container) scanDevice(row scannable) (*store.Device, error) {
 	device.SignedPreKey.Signature = (*[64]byte)(preKeySig)
 	device.Account = &account
 	device.FacebookUUID = fbUUID.UUID
+	device.DefaultDisappearingTimer = time.Duration(disappearingTimer) * time.Second
 
-	innerStore := NewSQLStore(c, *device.ID)
+	innerStore := c.getStore(*device.ID)
 	device.Identities = innerStore
 	device.Sessions = innerStore
 	device.PreKeys = innerStore
@@ -136,6 +208,13 @@ func (c *Container) scanDevice(row scannable) (*store.Device, error) {
 	device.ChatSettings = innerStore
 	device.MsgSecrets = innerStore
 	device.PrivacyTokens = innerStore
+	device.Polls = innerStore
+	device.Receipts = innerStore
+	device.Labels = innerStore
+	device.Stars = innerStore
+	device.LIDs = innerStore
+	device.LastMessages = innerStore
+	device.VerifiedIdentities = innerStore
 	device.Container = c
 	device.Initialized = true
 
@@ -187,15 +266,46 @@ func (c *Container) GetDevice(jid types.JID) (*store.Device, error) {
 	return sess, err
 }
 
+const allUploadedPreKeyCountsQuery = `
+	SELECT whatsmeow_device.jid, COUNT(whatsmeow_pre_keys.key_id)
+	FROM whatsmeow_device
+	LEFT JOIN whatsmeow_pre_keys ON whatsmeow_pre_keys.jid=whatsmeow_device.jid AND whatsmeow_pre_keys.uploaded=true
+	GROUP BY whatsmeow_device.jid
+`
+
+// AllUploadedPreKeyCounts returns the number of uploaded prekeys of every device in the database in a single query.
+// The map is keyed by the device JID string, and devices with no uploaded prekeys are included with a zero count.
+//
+// This is meant for monitoring many accounts at once; it returns the same numbers as calling
+// UploadedPreKeyCount on each device's store.
+func (c *Container) AllUploadedPreKeyCounts() (map[string]int, error) {
+	rows, err := c.db.Query(allUploadedPreKeyCountsQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	counts := make(map[string]int)
+	for rows.Next() {
+		var jid string
+		var count int
+		if err = rows.Scan(&jid, &count); err != nil {
+			return nil, err
+		}
+		counts[jid] = count
+	}
+	return counts, rows.Err()
+}
+
 const (
 	insertDeviceQuery = `
 		INSERT INTO whatsmeow_device (jid, registration_id, noise_key, identity_key,
 									  signed_pre_key, signed_pre_key_id, signed_pre_key_sig,
 									  adv_key, adv_details, adv_account_sig, adv_account_sig_key, adv_device_sig,
-									  platform, business_name, push_name, facebook_uuid)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+									  platform, business_name, push_name, facebook_uuid, default_disappearing_timer, lid)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
 		ON CONFLICT (jid) DO UPDATE
-		    SET platform=excluded.platform, business_name=excluded.business_name, push_name=excluded.push_name
+		    SET platform=excluded.platform, business_name=excluded.business_name, push_name=excluded.push_name,
+		        default_disappearing_timer=excluded.default_disappearing_timer, lid=excluded.lid
 	`
 	deleteDeviceQuery = `DELETE FROM whatsmeow_device WHERE jid=$1`
 )
@@ -241,10 +351,11 @@ func (c *Container) PutDevice(device *store.Device) error {
 		device.ID.String(), device.RegistrationID, device.NoiseKey.Priv[:], device.IdentityKey.Priv[:],
 		device.SignedPreKey.Priv[:], device.SignedPreKey.KeyID, device.SignedPreKey.Signature[:],
 		device.AdvSecretKey, device.Account.Details, device.Account.AccountSignature, device.Account.AccountSignatureKey, device.Account.DeviceSignature,
-		device.Platform, device.BusinessName, device.PushName, uuid.NullUUID{UUID: device.FacebookUUID, Valid: device.FacebookUUID != uuid.Nil})
+		device.Platform, device.BusinessName, device.PushName, uuid.NullUUID{UUID: device.FacebookUUID, Valid: device.FacebookUUID != uuid.Nil},
+		int64(device.DefaultDisappearingTimer.Seconds()), device.LID)
 
 	if !device.Initialized {
-		innerStore := NewSQLStore(c, *device.ID)
+		innerStore := c.getStore(*device.ID)
 		device.Identities = innerStore
 		device.Sessions = innerStore
 		device.PreKeys = innerStore
@@ -255,6 +366,13 @@ func (c *Container) PutDevice(device *store.Device) error {
 		device.ChatSettings = innerStore
 		device.MsgSecrets = innerStore
 		device.PrivacyTokens = innerStore
+		device.Polls = innerStore
+		device.Receipts = innerStore
+		device.Labels = innerStore
+		device.Stars = innerStore
+		device.LIDs = innerStore
+		device.LastMessages = innerStore
+		device.VerifiedIdentities = innerStore
 		device.Initialized = true
 	}
 	return err
@@ -266,5 +384,11 @@ func (c *Container) DeleteDevice(store *store.Device) error {
 		return ErrDeviceIDMustBeSet
 	}
 	_, err := c.db.Exec(deleteDeviceQuery, store.ID.String())
-	return err
+	if err != nil {
+		return err
+	}
+	c.storesLock.Lock()
+	delete(c.stores, store.ID.String())
+	c.storesLock.Unlock()
+	return nil
 }
@@ -0,0 +1,98 @@
+// Copyright (c) 2022 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package sqlstore
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+
+	"go.mau.fi/whatsmeow/types"
+)
+
+// nopDriver is a database driver that accepts all queries and never returns any rows,
+// which is enough to exercise the in-memory state of SQLStores without a real database.
+type nopDriver struct{}
+type nopConn struct{}
+type nopStmt struct{}
+type nopRows struct{}
+
+func (nopDriver) Open(string) (driver.Conn, error)         { return nopConn{}, nil }
+func (nopConn) Prepare(string) (driver.Stmt, error)        { return nopStmt{}, nil }
+func (nopConn) Close() error                               { return nil }
+func (nopConn) Begin() (driver.Tx, error)                  { return nopConn{}, nil }
+func (nopConn) Commit() error                              { return nil }
+func (nopConn) Rollback() error                            { return nil }
+func (nopStmt) Close() error                               { return nil }
+func (nopStmt) NumInput() int                              { return -1 }
+func (nopStmt) Exec([]driver.Value) (driver.Result, error) { return driver.RowsAffected(1), nil }
+func (nopStmt) Query([]driver.Value) (driver.Rows, error)  { return nopRows{}, nil }
+func (nopRows) Columns() []string                          { return []string{"value"} }
+func (nopRows) Close() error                               { return nil }
+func (nopRows) Next([]driver.Value) error                  { return io.EOF }
+
+func init() {
+	sql.Register("whatsmeow-nop", nopDriver{})
+}
+
+func TestConcurrentDevicesShareContainer(t *testing.T) {
+	db, err := sql.Open("whatsmeow-nop", "")
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	container := NewWithDB(db, "postgres", nil)
+	container.SessionCacheSize = 16
+
+	const accounts = 50
+	const goroutinesPerAccount = 4
+	stores := make([][goroutinesPerAccount]*SQLStore, accounts)
+	var wg sync.WaitGroup
+	for i := 0; i < accounts; i++ {
+		jid := types.NewADJID(fmt.Sprintf("1555000%04d", i), 0, 1)
+		for j := 0; j < goroutinesPerAccount; j++ {
+			wg.Add(1)
+			go func(i, j int) {
+				defer wg.Done()
+				s := container.getStore(jid)
+				stores[i][j] = s
+				for k := 0; k < 100; k++ {
+					address := fmt.Sprintf("1666000%04d:%d.0", k, j)
+					ownAddress := fmt.Sprintf("%s:%d.0", jid.User, k%3)
+					if err := s.PutSession(address, []byte{byte(k)}); err != nil {
+						t.Errorf("Failed to put session: %v", err)
+					}
+					if _, err := s.GetSession(address); err != nil {
+						t.Errorf("Failed to get session: %v", err)
+					}
+					if err := s.PutIdentity(ownAddress, [32]byte{byte(k)}); err != nil {
+						t.Errorf("Failed to put identity: %v", err)
+					}
+					if _, err := s.IsTrustedIdentity(address, [32]byte{byte(k)}); err != nil {
+						t.Errorf("Failed to check identity: %v", err)
+					}
+					if k%10 == 0 {
+						_ = s.DeleteAllSessionsAndIdentities(jid.User)
+					}
+				}
+			}(i, j)
+		}
+	}
+	wg.Wait()
+	for i, accountStores := range stores {
+		for _, s := range accountStores[1:] {
+			if s != accountStores[0] {
+				t.Errorf("Account %d got multiple different stores from the same container", i)
+			}
+		}
+		if i > 0 && accountStores[0] == stores[i-1][0] {
+			t.Errorf("Accounts %d and %d got the same store", i-1, i)
+		}
+	}
+}
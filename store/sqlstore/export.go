@@ -0,0 +1,214 @@
+// Copyright (c) 2022 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package sqlstore
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// SessionExportVersion is the version of the format produced by ExportSession.
+// ImportSession refuses blobs with a different version.
+const SessionExportVersion = 1
+
+// Errors returned by ImportSession if the given blob is not valid.
+var (
+	ErrUnsupportedSessionExportVersion = errors.New("unsupported session export version")
+	ErrSessionExportAddressMismatch    = errors.New("session export is for a different address")
+	ErrEmptySessionExport              = errors.New("session export doesn't contain any data")
+)
+
+// SessionExport contains all the Signal state stored for a single remote address (a user:device pair).
+//
+// The contents are the same opaque blobs that are stored in the database,
+// so they can only be imported to a store belonging to the same account.
+type SessionExport struct {
+	Version    int               `json:"version"`
+	Address    string            `json:"address"`
+	Identity   []byte            `json:"identity,omitempty"`
+	Session    []byte            `json:"session,omitempty"`
+	SenderKeys map[string][]byte `json:"sender_keys,omitempty"` // Group chat ID -> sender key
+}
+
+const (
+	getAllSessionAddressesQuery = `SELECT their_id FROM whatsmeow_sessions WHERE our_jid=$1`
+	getSenderKeysBySenderQuery  = `SELECT chat_id, sender_key FROM whatsmeow_sender_keys WHERE our_jid=$1 AND sender_id=$2`
+)
+
+func (s *SQLStore) exportSession(address string) (*SessionExport, error) {
+	export := SessionExport{
+		Version: SessionExportVersion,
+		Address: address,
+	}
+	err := s.db.QueryRow(getIdentityQuery, s.JID, address).Scan(&export.Identity)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get identity: %w", err)
+	} else if export.Identity != nil && len(export.Identity) != 32 {
+		return nil, ErrInvalidLength
+	}
+	err = s.db.QueryRow(getSessionQuery, s.JID, address).Scan(&export.Session)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+	rows, err := s.db.Query(getSenderKeysBySenderQuery, s.JID, address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sender keys: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var chatID string
+		var senderKey []byte
+		err = rows.Scan(&chatID, &senderKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan sender key: %w", err)
+		}
+		if export.SenderKeys == nil {
+			export.SenderKeys = make(map[string][]byte)
+		}
+		export.SenderKeys[chatID] = senderKey
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate sender keys: %w", err)
+	}
+	return &export, nil
+}
+
+func (s *SQLStore) importSession(tx execable, address string, blob []byte) (*SessionExport, error) {
+	var export SessionExport
+	err := json.Unmarshal(blob, &export)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse session export: %w", err)
+	} else if export.Version != SessionExportVersion {
+		return nil, fmt.Errorf("%w %d", ErrUnsupportedSessionExportVersion, export.Version)
+	} else if export.Address != address {
+		return nil, fmt.Errorf("%w (%s, expected %s)", ErrSessionExportAddressMismatch, export.Address, address)
+	} else if export.Identity == nil && export.Session == nil && len(export.SenderKeys) == 0 {
+		return nil, ErrEmptySessionExport
+	} else if export.Identity != nil && len(export.Identity) != 32 {
+		return nil, fmt.Errorf("%w: identity key is %d bytes", ErrInvalidLength, len(export.Identity))
+	}
+	if export.Identity != nil {
+		_, err = tx.Exec(putIdentityQuery, s.JID, address, export.Identity)
+		if err != nil {
+			return nil, fmt.Errorf("failed to store identity: %w", err)
+		}
+	}
+	if export.Session != nil {
+		if s.BeforeSessionWrite != nil {
+			existing, err := s.GetSession(address)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get existing session for write hook: %w", err)
+			}
+			err = s.BeforeSessionWrite(s.JID, address, existing, export.Session)
+			if err != nil {
+				return nil, err
+			}
+		}
+		_, err = tx.Exec(putSessionQuery, s.JID, address, export.Session)
+		if err != nil {
+			return nil, fmt.Errorf("failed to store session: %w", err)
+		}
+		// The imported session may not be the one the device has received our sender keys over
+		_, err = tx.Exec(deleteSenderKeySharedQuery, s.JID, address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete sender key shared markers: %w", err)
+		}
+	}
+	for chatID, senderKey := range export.SenderKeys {
+		if len(senderKey) == 0 {
+			return nil, fmt.Errorf("%w: empty sender key for %s", ErrInvalidLength, chatID)
+		}
+		_, err = tx.Exec(putSenderKeyQuery, s.JID, chatID, address, senderKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to store sender key for %s: %w", chatID, err)
+		}
+	}
+	return &export, nil
+}
+
+// ExportSession exports the identity key, Signal session and sender keys of the given address
+// (e.g. 123456789:0) as a versioned blob that can be imported with ImportSession.
+func (s *SQLStore) ExportSession(address string) ([]byte, error) {
+	export, err := s.exportSession(address)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(export)
+}
+
+// ImportSession imports a blob created with ExportSession, overwriting any existing data for the same address.
+//
+// All the data is written in a single transaction, so nothing is stored if the blob is invalid.
+func (s *SQLStore) ImportSession(address string, blob []byte) error {
+	return s.ImportSessions(map[string][]byte{address: blob})
+}
+
+// ExportSessions exports multiple addresses like ExportSession.
+// If addresses is nil, all addresses that have a Signal session are exported.
+func (s *SQLStore) ExportSessions(addresses []string) (map[string][]byte, error) {
+	if addresses == nil {
+		rows, err := s.db.Query(getAllSessionAddressesQuery, s.JID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query session addresses: %w", err)
+		}
+		for rows.Next() {
+			var address string
+			if err = rows.Scan(&address); err != nil {
+				_ = rows.Close()
+				return nil, fmt.Errorf("failed to scan session address: %w", err)
+			}
+			addresses = append(addresses, address)
+		}
+		_ = rows.Close()
+		if err = rows.Err(); err != nil {
+			return nil, fmt.Errorf("failed to iterate session addresses: %w", err)
+		}
+	}
+	output := make(map[string][]byte, len(addresses))
+	for _, address := range addresses {
+		blob, err := s.ExportSession(address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to export %s: %w", address, err)
+		}
+		output[address] = blob
+	}
+	return output, nil
+}
+
+// ImportSessions imports multiple blobs created with ExportSession or ExportSessions in a single transaction.
+// If any of the blobs is invalid, nothing is imported.
+func (s *SQLStore) ImportSessions(blobs map[string][]byte) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	imported := make([]*SessionExport, 0, len(blobs))
+	for address, blob := range blobs {
+		export, err := s.importSession(tx, address, blob)
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to import %s: %w", address, err)
+		}
+		imported = append(imported, export)
+	}
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	// Update the caches only after committing, so that concurrent reads can't cache the old values afterwards
+	for _, export := range imported {
+		if export.Identity != nil {
+			s.cacheIdentity(export.Address, export.Identity)
+		}
+		if export.Session != nil {
+			s.sessionCache.Put(export.Address, export.Session)
+		}
+	}
+	return nil
+}
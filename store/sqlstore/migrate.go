@@ -0,0 +1,237 @@
+// Copyright (c) 2022 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package sqlstore
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Errors returned by Container.MigrateTo
+var (
+	ErrMigrationVersionMismatch  = errors.New("source and destination databases must both be upgraded to the latest schema version")
+	ErrMigrationRowCountMismatch = errors.New("row count in destination doesn't match source after migration")
+)
+
+type migrationColumnType int
+
+const (
+	migrationText migrationColumnType = iota
+	migrationBytes
+	migrationInt
+	migrationBool
+)
+
+type migrationColumn struct {
+	name string
+	typ  migrationColumnType
+}
+
+type migrationTable struct {
+	name    string
+	columns []migrationColumn
+}
+
+// migrationTables contains all the tables of the latest schema in foreign key dependency order.
+// This must be updated whenever a new upgrade adds tables or columns.
+var migrationTables = []migrationTable{
+	{"whatsmeow_device", []migrationColumn{
+		{"jid", migrationText}, {"registration_id", migrationInt}, {"noise_key", migrationBytes}, {"identity_key", migrationBytes},
+		{"signed_pre_key", migrationBytes}, {"signed_pre_key_id", migrationInt}, {"signed_pre_key_sig", migrationBytes},
+		{"adv_key", migrationBytes}, {"adv_details", migrationBytes}, {"adv_account_sig", migrationBytes}, {"adv_account_sig_key", migrationBytes}, {"adv_device_sig", migrationBytes},
+		{"platform", migrationText}, {"business_name", migrationText}, {"push_name", migrationText}, {"facebook_uuid", migrationText},
+		{"default_disappearing_timer", migrationInt}, {"lid", migrationText},
+	}},
+	{"whatsmeow_identity_keys", []migrationColumn{{"our_jid", migrationText}, {"their_id", migrationText}, {"identity", migrationBytes}}},
+	{"whatsmeow_pre_keys", []migrationColumn{{"jid", migrationText}, {"key_id", migrationInt}, {"key", migrationBytes}, {"uploaded", migrationBool}}},
+	{"whatsmeow_sessions", []migrationColumn{{"our_jid", migrationText}, {"their_id", migrationText}, {"session", migrationBytes}}},
+	{"whatsmeow_sender_keys", []migrationColumn{{"our_jid", migrationText}, {"chat_id", migrationText}, {"sender_id", migrationText}, {"sender_key", migrationBytes}}},
+	{"whatsmeow_app_state_sync_keys", []migrationColumn{
+		{"jid", migrationText}, {"key_id", migrationBytes}, {"key_data", migrationBytes}, {"timestamp", migrationInt}, {"fingerprint", migrationBytes},
+	}},
+	{"whatsmeow_app_state_version", []migrationColumn{{"jid", migrationText}, {"name", migrationText}, {"version", migrationInt}, {"hash", migrationBytes}}},
+	{"whatsmeow_app_state_mutation_macs", []migrationColumn{
+		{"jid", migrationText}, {"name", migrationText}, {"version", migrationInt}, {"index_mac", migrationBytes}, {"value_mac", migrationBytes},
+	}},
+	{"whatsmeow_contacts", []migrationColumn{
+		{"our_jid", migrationText}, {"their_jid", migrationText}, {"first_name", migrationText}, {"full_name", migrationText}, {"push_name", migrationText}, {"business_name", migrationText},
+	}},
+	{"whatsmeow_chat_settings", []migrationColumn{
+		{"our_jid", migrationText}, {"chat_jid", migrationText}, {"muted_until", migrationInt}, {"pinned", migrationBool}, {"archived", migrationBool},
+	}},
+	{"whatsmeow_message_secrets", []migrationColumn{
+		{"our_jid", migrationText}, {"chat_jid", migrationText}, {"sender_jid", migrationText}, {"message_id", migrationText}, {"key", migrationBytes},
+	}},
+	{"whatsmeow_privacy_tokens", []migrationColumn{{"our_jid", migrationText}, {"their_jid", migrationText}, {"token", migrationBytes}, {"timestamp", migrationInt}}},
+	{"whatsmeow_sender_key_shared", []migrationColumn{{"our_jid", migrationText}, {"chat_id", migrationText}, {"device_id", migrationText}}},
+	{"whatsmeow_poll_options", []migrationColumn{
+		{"our_jid", migrationText}, {"chat_jid", migrationText}, {"sender_jid", migrationText}, {"poll_id", migrationText},
+		{"option_index", migrationInt}, {"option_name", migrationText},
+	}},
+	{"whatsmeow_poll_votes", []migrationColumn{
+		{"our_jid", migrationText}, {"chat_jid", migrationText}, {"sender_jid", migrationText}, {"poll_id", migrationText},
+		{"voter_jid", migrationText}, {"selected_options", migrationBytes}, {"timestamp", migrationInt},
+	}},
+	{"whatsmeow_message_receipts", []migrationColumn{
+		{"our_jid", migrationText}, {"chat_jid", migrationText}, {"message_id", migrationText}, {"receiver_jid", migrationText},
+		{"delivered_at", migrationInt}, {"read_at", migrationInt}, {"played_at", migrationInt},
+	}},
+	{"whatsmeow_labels", []migrationColumn{
+		{"our_jid", migrationText}, {"label_id", migrationText}, {"name", migrationText}, {"color", migrationInt}, {"predefined_id", migrationInt},
+	}},
+	{"whatsmeow_chat_labels", []migrationColumn{{"our_jid", migrationText}, {"chat_jid", migrationText}, {"label_id", migrationText}}},
+	{"whatsmeow_starred_messages", []migrationColumn{
+		{"our_jid", migrationText}, {"chat_jid", migrationText}, {"message_id", migrationText},
+		{"sender_jid", migrationText}, {"from_me", migrationBool}, {"starred_at", migrationInt},
+	}},
+	{"whatsmeow_lid_map", []migrationColumn{{"our_jid", migrationText}, {"lid", migrationText}, {"pn", migrationText}}},
+	{"whatsmeow_last_messages", []migrationColumn{
+		{"our_jid", migrationText}, {"chat_jid", migrationText}, {"message_id", migrationText}, {"timestamp", migrationInt},
+	}},
+	{"whatsmeow_verified_identities", []migrationColumn{{"our_jid", migrationText}, {"their_id", migrationText}, {"identity", migrationBytes}}},
+}
+
+func (table *migrationTable) scanTargets() []any {
+	targets := make([]any, len(table.columns))
+	for i, col := range table.columns {
+		switch col.typ {
+		case migrationText:
+			targets[i] = &sql.NullString{}
+		case migrationBytes:
+			targets[i] = &[]byte{}
+		case migrationInt:
+			targets[i] = &sql.NullInt64{}
+		case migrationBool:
+			targets[i] = &sql.NullBool{}
+		}
+	}
+	return targets
+}
+
+func (table *migrationTable) insertQuery() string {
+	names := make([]string, len(table.columns))
+	placeholders := make([]string, len(table.columns))
+	for i, col := range table.columns {
+		names[i] = col.name
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+	}
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", table.name, strings.Join(names, ", "), strings.Join(placeholders, ", "))
+}
+
+func (table *migrationTable) copyRows(src *sql.DB, dst *sql.Tx) (int, error) {
+	names := make([]string, len(table.columns))
+	for i, col := range table.columns {
+		names[i] = col.name
+	}
+	rows, err := src.Query(fmt.Sprintf("SELECT %s FROM %s", strings.Join(names, ", "), table.name))
+	if err != nil {
+		return 0, fmt.Errorf("failed to query rows: %w", err)
+	}
+	defer rows.Close()
+	insertQuery := table.insertQuery()
+	count := 0
+	for rows.Next() {
+		targets := table.scanTargets()
+		err = rows.Scan(targets...)
+		if err != nil {
+			return count, fmt.Errorf("failed to scan row: %w", err)
+		}
+		values := make([]any, len(targets))
+		for i, target := range targets {
+			switch typedTarget := target.(type) {
+			case *[]byte:
+				values[i] = *typedTarget
+			case *sql.NullString:
+				if typedTarget.Valid {
+					values[i] = typedTarget.String
+				}
+			case *sql.NullInt64:
+				if typedTarget.Valid {
+					values[i] = typedTarget.Int64
+				}
+			case *sql.NullBool:
+				if typedTarget.Valid {
+					values[i] = typedTarget.Bool
+				}
+			}
+		}
+		_, err = dst.Exec(insertQuery, values...)
+		if err != nil {
+			return count, fmt.Errorf("failed to insert row: %w", err)
+		}
+		count++
+	}
+	return count, rows.Err()
+}
+
+func countRows(db interface {
+	QueryRow(query string, args ...any) *sql.Row
+}, table string) (count int, err error) {
+	err = db.QueryRow(fmt.Sprintf("SELECT COUNT(*) FROM %s", table)).Scan(&count)
+	return
+}
+
+// MigrateTo copies all whatsmeow data (all devices and their keys, sessions, app state, contacts, etc.)
+// from this container to the given container. This can be used to move a store between database dialects,
+// e.g. from SQLite to Postgres.
+//
+// Both databases must be upgraded to the latest schema version (which New does automatically), and the destination
+// must not already contain any of the devices in this container. All data is written in a single transaction
+// on the destination, and the row counts of each table are verified before committing. The data is copied as-is,
+// so the length checks of the schema (e.g. 32-byte keys and 128-byte app state hashes) are enforced on insert.
+func (c *Container) MigrateTo(dst *Container) error {
+	srcVersion, err := c.getVersion()
+	if err != nil {
+		return fmt.Errorf("failed to get source database version: %w", err)
+	}
+	dstVersion, err := dst.getVersion()
+	if err != nil {
+		return fmt.Errorf("failed to get destination database version: %w", err)
+	}
+	if srcVersion != dstVersion || srcVersion != len(Upgrades) {
+		return fmt.Errorf("%w (source: v%d, destination: v%d, latest: v%d)", ErrMigrationVersionMismatch, srcVersion, dstVersion, len(Upgrades))
+	}
+	tx, err := dst.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	for _, table := range migrationTables {
+		var srcCount, countBefore, countAfter, copied int
+		srcCount, err = countRows(c.db, table.name)
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to count rows in source %s: %w", table.name, err)
+		}
+		countBefore, err = countRows(tx, table.name)
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to count rows in destination %s: %w", table.name, err)
+		}
+		copied, err = table.copyRows(c.db, tx)
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to copy %s: %w", table.name, err)
+		}
+		countAfter, err = countRows(tx, table.name)
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to count rows in destination %s: %w", table.name, err)
+		} else if copied != srcCount || countAfter-countBefore != srcCount {
+			_ = tx.Rollback()
+			return fmt.Errorf("%w: %s has %d rows in source, copied %d, destination has %d new rows",
+				ErrMigrationRowCountMismatch, table.name, srcCount, copied, countAfter-countBefore)
+		}
+		c.log.Debugf("Copied %d rows in %s", copied, table.name)
+	}
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
@@ -8,6 +8,8 @@
 package sqlstore
 
 import (
+	"bytes"
+	"context"
 	"database/sql"
 	"database/sql/driver"
 	"errors"
@@ -25,6 +27,10 @@ import (
 // This should be impossible, as the database schema contains CHECK()s for all the relevant columns.
 var ErrInvalidLength = errors.New("database returned byte array with illegal length")
 
+// ErrEmptySession is returned by PutSession if the session data is empty. Storing an empty session would
+// make all future decryption with that device fail, so it's always rejected.
+var ErrEmptySession = errors.New("refusing to store empty session")
+
 // PostgresArrayWrapper is a function to wrap array values before passing them to the sql package.
 //
 // When using github.com/lib/pq, you should set
@@ -43,6 +49,16 @@ type SQLStore struct {
 
 	contactCache     map[types.JID]*types.ContactInfo
 	contactCacheLock sync.Mutex
+
+	// ownIdentityCache contains the identity keys of our own other devices, which are checked for every message
+	// those devices send. Other identities are only cached in identityCache if Container.SessionCacheSize is set.
+	ownUser              string
+	ownIdentityCache     map[string][32]byte
+	ownIdentityCacheLock sync.RWMutex
+
+	// sessionCache and identityCache are only set if Container.SessionCacheSize is enabled.
+	sessionCache  *lruCache[[]byte]
+	identityCache *lruCache[[32]byte]
 }
 
 // NewSQLStore creates a new SQLStore with the given database container and user JID.
@@ -50,10 +66,20 @@ type SQLStore struct {
 //
 // In general, you should use Container.NewDevice or Container.GetDevice instead of this.
 func NewSQLStore(c *Container, jid types.JID) *SQLStore {
+	var sessionCache *lruCache[[]byte]
+	var identityCache *lruCache[[32]byte]
+	if c.SessionCacheSize > 0 {
+		sessionCache = newLRUCache[[]byte](c.SessionCacheSize, c.SessionCacheTTL)
+		identityCache = newLRUCache[[32]byte](c.SessionCacheSize, c.SessionCacheTTL)
+	}
 	return &SQLStore{
-		Container:    c,
-		JID:          jid.String(),
-		contactCache: make(map[types.JID]*types.ContactInfo),
+		Container:        c,
+		JID:              jid.String(),
+		contactCache:     make(map[types.JID]*types.ContactInfo),
+		ownUser:          jid.User,
+		ownIdentityCache: make(map[string][32]byte),
+		sessionCache:     sessionCache,
+		identityCache:    identityCache,
 	}
 }
 
@@ -64,43 +90,179 @@ var _ store.SenderKeyStore = (*SQLStore)(nil)
 var _ store.AppStateSyncKeyStore = (*SQLStore)(nil)
 var _ store.AppStateStore = (*SQLStore)(nil)
 var _ store.ContactStore = (*SQLStore)(nil)
+var _ store.ChatSettingsStore = (*SQLStore)(nil)
+var _ store.MsgSecretStore = (*SQLStore)(nil)
+var _ store.PrivacyTokenStore = (*SQLStore)(nil)
+var _ store.PollStore = (*SQLStore)(nil)
+var _ store.MessageReceiptStore = (*SQLStore)(nil)
+var _ store.LabelStore = (*SQLStore)(nil)
+var _ store.StarredMessageStore = (*SQLStore)(nil)
+var _ store.LIDStore = (*SQLStore)(nil)
+var _ store.LastMessageStore = (*SQLStore)(nil)
+var _ store.VerifiedIdentityStore = (*SQLStore)(nil)
 
 const (
 	putIdentityQuery = `
 		INSERT INTO whatsmeow_identity_keys (our_jid, their_id, identity) VALUES ($1, $2, $3)
 		ON CONFLICT (our_jid, their_id) DO UPDATE SET identity=excluded.identity
 	`
+	putManyIdentitiesQuery = `
+		INSERT INTO whatsmeow_identity_keys (our_jid, their_id, identity)
+		VALUES %s
+		ON CONFLICT (our_jid, their_id) DO UPDATE SET identity=excluded.identity
+	`
 	deleteAllIdentitiesQuery = `DELETE FROM whatsmeow_identity_keys WHERE our_jid=$1 AND their_id LIKE $2`
 	deleteIdentityQuery      = `DELETE FROM whatsmeow_identity_keys WHERE our_jid=$1 AND their_id=$2`
 	getIdentityQuery         = `SELECT identity FROM whatsmeow_identity_keys WHERE our_jid=$1 AND their_id=$2`
 )
 
+func (s *SQLStore) isOwnAddress(address string) bool {
+	return len(s.ownUser) > 0 && strings.HasPrefix(address, s.ownUser+":")
+}
+
+func (s *SQLStore) getCachedIdentity(address string) (key [32]byte, ok bool) {
+	if !s.isOwnAddress(address) {
+		return s.identityCache.Get(address)
+	}
+	s.ownIdentityCacheLock.RLock()
+	key, ok = s.ownIdentityCache[address]
+	s.ownIdentityCacheLock.RUnlock()
+	return
+}
+
+func (s *SQLStore) cacheIdentity(address string, key []byte) {
+	if len(key) != 32 {
+		return
+	} else if !s.isOwnAddress(address) {
+		s.identityCache.Put(address, *(*[32]byte)(key))
+		return
+	}
+	s.ownIdentityCacheLock.Lock()
+	s.ownIdentityCache[address] = *(*[32]byte)(key)
+	s.ownIdentityCacheLock.Unlock()
+}
+
 func (s *SQLStore) PutIdentity(address string, key [32]byte) error {
 	_, err := s.db.Exec(putIdentityQuery, s.JID, address, key[:])
+	if err == nil {
+		s.cacheIdentity(address, key[:])
+	}
+	return err
+}
+
+const identityBatchSize = 300
+
+func (s *SQLStore) putIdentitiesBatch(tx execable, addresses []string, identities map[string][32]byte) error {
+	values := make([]interface{}, 1, 1+len(addresses)*2)
+	queryParts := make([]string, len(addresses))
+	values[0] = s.JID
+	placeholderSyntax := "($1, $%d, $%d)"
+	if s.dialect == "sqlite3" {
+		placeholderSyntax = "(?1, ?%d, ?%d)"
+	}
+	for i, address := range addresses {
+		key := identities[address]
+		baseIndex := i*2 + 1
+		values = append(values, address, key[:])
+		queryParts[i] = fmt.Sprintf(placeholderSyntax, baseIndex+1, baseIndex+2)
+	}
+	_, err := tx.Exec(fmt.Sprintf(putManyIdentitiesQuery, strings.Join(queryParts, ",")), values...)
 	return err
 }
 
+// PutIdentities stores multiple identity keys in a single transaction using multi-row inserts.
+// This is faster than calling PutIdentity for each key when a lot of identities are learned at once.
+func (s *SQLStore) PutIdentities(identities map[string][32]byte) (err error) {
+	if len(identities) == 0 {
+		return nil
+	}
+	addresses := make([]string, 0, len(identities))
+	for address := range identities {
+		addresses = append(addresses, address)
+	}
+	defer func() {
+		if err != nil {
+			return
+		}
+		for address, key := range identities {
+			s.cacheIdentity(address, key[:])
+		}
+	}()
+	if len(addresses) <= identityBatchSize {
+		return s.putIdentitiesBatch(s.db, addresses, identities)
+	}
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	for i := 0; i < len(addresses); i += identityBatchSize {
+		end := min(i+identityBatchSize, len(addresses))
+		err = s.putIdentitiesBatch(tx, addresses[i:end], identities)
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
 func (s *SQLStore) DeleteAllIdentities(phone string) error {
 	_, err := s.db.Exec(deleteAllIdentitiesQuery, s.JID, phone+":%")
+	s.identityCache.DeletePrefix(phone + ":")
+	if phone == s.ownUser {
+		s.ownIdentityCacheLock.Lock()
+		clear(s.ownIdentityCache)
+		s.ownIdentityCacheLock.Unlock()
+	}
 	return err
 }
 
 func (s *SQLStore) DeleteIdentity(address string) error {
-	_, err := s.db.Exec(deleteAllIdentitiesQuery, s.JID, address)
+	_, err := s.db.Exec(deleteIdentityQuery, s.JID, address)
+	s.identityCache.Delete(address)
+	if s.isOwnAddress(address) {
+		s.ownIdentityCacheLock.Lock()
+		delete(s.ownIdentityCache, address)
+		s.ownIdentityCacheLock.Unlock()
+	}
 	return err
 }
 
+// GetIdentity returns the stored identity key of the given address, or nil if there is no stored identity.
+func (s *SQLStore) GetIdentity(address string) (identity []byte, err error) {
+	if cached, ok := s.getCachedIdentity(address); ok {
+		return cached[:], nil
+	}
+	err = s.db.QueryRow(getIdentityQuery, s.JID, address).Scan(&identity)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err == nil && len(identity) != 32 {
+		return nil, ErrInvalidLength
+	} else if err == nil {
+		s.cacheIdentity(address, identity)
+	}
+	return
+}
+
 func (s *SQLStore) IsTrustedIdentity(address string, key [32]byte) (bool, error) {
+	if cached, ok := s.getCachedIdentity(address); ok {
+		return cached == key, nil
+	}
 	var existingIdentity []byte
 	err := s.db.QueryRow(getIdentityQuery, s.JID, address).Scan(&existingIdentity)
 	if errors.Is(err, sql.ErrNoRows) {
 		// Trust if not known, it'll be saved automatically later
-		return true, nil
+		return !s.RejectUnknownIdentities, nil
 	} else if err != nil {
 		return false, err
 	} else if len(existingIdentity) != 32 {
 		return false, ErrInvalidLength
 	}
+	s.cacheIdentity(address, existingIdentity)
 	return *(*[32]byte)(existingIdentity) == key, nil
 }
 
@@ -111,19 +273,32 @@ const (
 		INSERT INTO whatsmeow_sessions (our_jid, their_id, session) VALUES ($1, $2, $3)
 		ON CONFLICT (our_jid, their_id) DO UPDATE SET session=excluded.session
 	`
-	deleteAllSessionsQuery = `DELETE FROM whatsmeow_sessions WHERE our_jid=$1 AND their_id LIKE $2`
-	deleteSessionQuery     = `DELETE FROM whatsmeow_sessions WHERE our_jid=$1 AND their_id=$2`
+	deleteAllSessionsQuery       = `DELETE FROM whatsmeow_sessions WHERE our_jid=$1 AND their_id LIKE $2`
+	deleteSessionQuery           = `DELETE FROM whatsmeow_sessions WHERE our_jid=$1 AND their_id=$2`
+	getUserSessionAddressesQuery = `SELECT their_id FROM whatsmeow_sessions WHERE our_jid=$1 AND their_id LIKE $2`
+	// The sender key shared markers are deleted along with sessions, because a new session with the device
+	// means it needs to receive the sender key again.
+	deleteAllSenderKeySharedQuery = `DELETE FROM whatsmeow_sender_key_shared WHERE our_jid=$1 AND device_id LIKE $2`
+	deleteSenderKeySharedQuery    = `DELETE FROM whatsmeow_sender_key_shared WHERE our_jid=$1 AND device_id=$2`
 )
 
 func (s *SQLStore) GetSession(address string) (session []byte, err error) {
+	if cached, ok := s.sessionCache.Get(address); ok {
+		return cached, nil
+	}
 	err = s.db.QueryRow(getSessionQuery, s.JID, address).Scan(&session)
 	if errors.Is(err, sql.ErrNoRows) {
 		err = nil
+	} else if err == nil {
+		s.sessionCache.Put(address, session)
 	}
 	return
 }
 
 func (s *SQLStore) HasSession(address string) (has bool, err error) {
+	if _, ok := s.sessionCache.Get(address); ok {
+		return true, nil
+	}
 	err = s.db.QueryRow(hasSessionQuery, s.JID, address).Scan(&has)
 	if errors.Is(err, sql.ErrNoRows) {
 		err = nil
@@ -132,20 +307,104 @@ func (s *SQLStore) HasSession(address string) (has bool, err error) {
 }
 
 func (s *SQLStore) PutSession(address string, session []byte) error {
+	if len(session) == 0 {
+		return fmt.Errorf("%w for %s", ErrEmptySession, address)
+	}
+	if s.BeforeSessionWrite != nil {
+		existing, err := s.GetSession(address)
+		if err != nil {
+			return fmt.Errorf("failed to get existing session for write hook: %w", err)
+		}
+		err = s.BeforeSessionWrite(s.JID, address, existing, session)
+		if err != nil {
+			return err
+		}
+	}
 	_, err := s.db.Exec(putSessionQuery, s.JID, address, session)
+	if err != nil {
+		s.sessionCache.Delete(address)
+	} else {
+		s.sessionCache.Put(address, session)
+	}
 	return err
 }
 
 func (s *SQLStore) DeleteAllSessions(phone string) error {
 	_, err := s.db.Exec(deleteAllSessionsQuery, s.JID, phone+":%")
+	s.sessionCache.DeletePrefix(phone + ":")
+	if err != nil {
+		return err
+	}
+	_, err = s.db.Exec(deleteAllSenderKeySharedQuery, s.JID, phone+":%")
 	return err
 }
 
 func (s *SQLStore) DeleteSession(address string) error {
 	_, err := s.db.Exec(deleteSessionQuery, s.JID, address)
+	s.sessionCache.Delete(address)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.Exec(deleteSenderKeySharedQuery, s.JID, address)
 	return err
 }
 
+// GetUserSessionAddresses returns the addresses (e.g. 123456789:0, 123456789:5) of all devices
+// of the given user that have a Signal session stored.
+func (s *SQLStore) GetUserSessionAddresses(phone string) ([]string, error) {
+	rows, err := s.db.Query(getUserSessionAddressesQuery, s.JID, phone+":%")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var addresses []string
+	for rows.Next() {
+		var address string
+		if err = rows.Scan(&address); err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, address)
+	}
+	return addresses, rows.Err()
+}
+
+// DeleteAllSessionsAndIdentities deletes the Signal sessions and identity keys of all devices of the given user
+// in a single transaction.
+func (s *SQLStore) DeleteAllSessionsAndIdentities(phone string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	_, err = tx.Exec(deleteAllSessionsQuery, s.JID, phone+":%")
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to delete sessions: %w", err)
+	}
+	_, err = tx.Exec(deleteAllIdentitiesQuery, s.JID, phone+":%")
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to delete identities: %w", err)
+	}
+	_, err = tx.Exec(deleteAllSenderKeySharedQuery, s.JID, phone+":%")
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to delete sender key shared markers: %w", err)
+	}
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	// The caches are cleared after committing, so that concurrent reads can't cache rows that are being deleted
+	s.sessionCache.DeletePrefix(phone + ":")
+	s.identityCache.DeletePrefix(phone + ":")
+	if phone == s.ownUser {
+		s.ownIdentityCacheLock.Lock()
+		clear(s.ownIdentityCache)
+		s.ownIdentityCacheLock.Unlock()
+	}
+	return nil
+}
+
 const (
 	getLastPreKeyIDQuery        = `SELECT MAX(key_id) FROM whatsmeow_pre_keys WHERE jid=$1`
 	insertPreKeyQuery           = `INSERT INTO whatsmeow_pre_keys (jid, key_id, key, uploaded) VALUES ($1, $2, $3, $4)`
@@ -262,6 +521,7 @@ const (
 		INSERT INTO whatsmeow_sender_keys (our_jid, chat_id, sender_id, sender_key) VALUES ($1, $2, $3, $4)
 		ON CONFLICT (our_jid, chat_id, sender_id) DO UPDATE SET sender_key=excluded.sender_key
 	`
+	deleteSenderKeyQuery = `DELETE FROM whatsmeow_sender_keys WHERE our_jid=$1 AND chat_id=$2 AND sender_id=$3`
 )
 
 func (s *SQLStore) PutSenderKey(group, user string, session []byte) error {
@@ -269,6 +529,11 @@ func (s *SQLStore) PutSenderKey(group, user string, session []byte) error {
 	return err
 }
 
+func (s *SQLStore) DeleteSenderKey(group, user string) error {
+	_, err := s.db.Exec(deleteSenderKeyQuery, s.JID, group, user)
+	return err
+}
+
 func (s *SQLStore) GetSenderKey(group, user string) (key []byte, err error) {
 	err = s.db.QueryRow(getSenderKeyQuery, s.JID, group, user).Scan(&key)
 	if errors.Is(err, sql.ErrNoRows) {
@@ -277,6 +542,56 @@ func (s *SQLStore) GetSenderKey(group, user string) (key []byte, err error) {
 	return
 }
 
+const (
+	getSenderKeySharedQuery   = `SELECT device_id FROM whatsmeow_sender_key_shared WHERE our_jid=$1 AND chat_id=$2`
+	putSenderKeySharedQuery   = `INSERT INTO whatsmeow_sender_key_shared (our_jid, chat_id, device_id) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`
+	resetSenderKeySharedQuery = `DELETE FROM whatsmeow_sender_key_shared WHERE our_jid=$1 AND chat_id=$2`
+)
+
+func (s *SQLStore) GetSenderKeySharedWith(group string) (map[string]struct{}, error) {
+	rows, err := s.db.Query(getSenderKeySharedQuery, s.JID, group)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	shared := make(map[string]struct{})
+	for rows.Next() {
+		var address string
+		if err = rows.Scan(&address); err != nil {
+			return nil, err
+		}
+		shared[address] = struct{}{}
+	}
+	return shared, rows.Err()
+}
+
+func (s *SQLStore) MarkSenderKeyShared(group string, addresses []string) error {
+	if len(addresses) == 0 {
+		return nil
+	}
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	for _, address := range addresses {
+		_, err = tx.Exec(putSenderKeySharedQuery, s.JID, group, address)
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (s *SQLStore) ResetSenderKeyShared(group string) error {
+	_, err := s.db.Exec(resetSenderKeySharedQuery, s.JID, group)
+	return err
+}
+
 const (
 	putAppStateSyncKeyQuery = `
 		INSERT INTO whatsmeow_app_state_sync_keys (jid, key_id, key_data, timestamp, fingerprint) VALUES ($1, $2, $3, $4, $5)
@@ -286,6 +601,7 @@ const (
 	`
 	getAppStateSyncKeyQuery         = `SELECT key_data, timestamp, fingerprint FROM whatsmeow_app_state_sync_keys WHERE jid=$1 AND key_id=$2`
 	getLatestAppStateSyncKeyIDQuery = `SELECT key_id FROM whatsmeow_app_state_sync_keys WHERE jid=$1 ORDER BY timestamp DESC LIMIT 1`
+	getAllAppStateSyncKeyIDsQuery   = `SELECT key_id FROM whatsmeow_app_state_sync_keys WHERE jid=$1 ORDER BY timestamp DESC`
 )
 
 func (s *SQLStore) PutAppStateSyncKey(id []byte, key store.AppStateSyncKey) error {
@@ -311,17 +627,43 @@ func (s *SQLStore) GetLatestAppStateSyncKeyID() ([]byte, error) {
 	return keyID, err
 }
 
+func (s *SQLStore) GetAllAppStateSyncKeyIDs() (keyIDs [][]byte, err error) {
+	rows, err := s.db.Query(getAllAppStateSyncKeyIDsQuery, s.JID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var keyID []byte
+		err = rows.Scan(&keyID)
+		if err != nil {
+			return nil, err
+		}
+		keyIDs = append(keyIDs, keyID)
+	}
+	return keyIDs, rows.Err()
+}
+
 const (
 	putAppStateVersionQuery = `
 		INSERT INTO whatsmeow_app_state_version (jid, name, version, hash) VALUES ($1, $2, $3, $4)
 		ON CONFLICT (jid, name) DO UPDATE SET version=excluded.version, hash=excluded.hash
 	`
 	getAppStateVersionQuery                 = `SELECT version, hash FROM whatsmeow_app_state_version WHERE jid=$1 AND name=$2`
+	getAllAppStateVersionsQuery             = `SELECT name, version FROM whatsmeow_app_state_version WHERE jid=$1`
 	deleteAppStateVersionQuery              = `DELETE FROM whatsmeow_app_state_version WHERE jid=$1 AND name=$2`
 	putAppStateMutationMACsQuery            = `INSERT INTO whatsmeow_app_state_mutation_macs (jid, name, version, index_mac, value_mac) VALUES `
 	deleteAppStateMutationMACsQueryPostgres = `DELETE FROM whatsmeow_app_state_mutation_macs WHERE jid=$1 AND name=$2 AND index_mac=ANY($3::bytea[])`
 	deleteAppStateMutationMACsQueryGeneric  = `DELETE FROM whatsmeow_app_state_mutation_macs WHERE jid=$1 AND name=$2 AND index_mac IN `
 	getAppStateMutationMACQuery             = `SELECT value_mac FROM whatsmeow_app_state_mutation_macs WHERE jid=$1 AND name=$2 AND index_mac=$3 ORDER BY version DESC LIMIT 1`
+	// The rows are sorted by index MAC and newest version first, so the first row of each index MAC is the current value.
+	// Pages are fetched using the last seen index MAC as the cursor, which skips any older versions left on the previous page.
+	getAppStateValueMACPageQuery = `
+		SELECT index_mac, value_mac FROM whatsmeow_app_state_mutation_macs
+		WHERE jid=$1 AND name=$2 AND index_mac>$3
+		ORDER BY index_mac, version DESC
+		LIMIT $4
+	`
 )
 
 func (s *SQLStore) PutAppStateVersion(name string, version uint64, hash [128]byte) error {
@@ -347,6 +689,25 @@ func (s *SQLStore) GetAppStateVersion(name string) (version uint64, hash [128]by
 	return
 }
 
+func (s *SQLStore) GetAppStateVersions() (map[string]uint64, error) {
+	rows, err := s.db.Query(getAllAppStateVersionsQuery, s.JID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	versions := make(map[string]uint64)
+	for rows.Next() {
+		var name string
+		var version uint64
+		err = rows.Scan(&name, &version)
+		if err != nil {
+			return nil, err
+		}
+		versions[name] = version
+	}
+	return versions, rows.Err()
+}
+
 func (s *SQLStore) DeleteAppStateVersion(name string) error {
 	_, err := s.db.Exec(deleteAppStateVersionQuery, s.JID, name)
 	return err
@@ -376,26 +737,74 @@ func (s *SQLStore) putAppStateMutationMACs(tx execable, name string, version uin
 	return err
 }
 
-const mutationBatchSize = 400
+const (
+	// Maximum number of parameters in a single query. Postgres allows 65535,
+	// while SQLite only allows 999 in versions older than 3.32.
+	postgresMaxQueryParams = 65535
+	sqliteMaxQueryParams   = 999
+	// Each mutation MAC row has 2 parameters, plus 3 parameters shared by all rows.
+	mutationQueryParamsPerRow = 2
+	mutationQuerySharedParams = 3
+)
+
+func (s *SQLStore) getMutationBatchSize() int {
+	if s.MutationBatchSize > 0 {
+		return s.MutationBatchSize
+	}
+	maxParams := postgresMaxQueryParams
+	if s.dialect == "sqlite3" {
+		maxParams = sqliteMaxQueryParams
+	}
+	return (maxParams - mutationQuerySharedParams) / mutationQueryParamsPerRow
+}
+
+func (s *SQLStore) putAppStateMutationMACBatches(ctx context.Context, tx execable, name string, version uint64, mutations []store.AppStateMutationMAC, batchSize int, progress func(done, total int)) error {
+	totalBatches := (len(mutations) + batchSize - 1) / batchSize
+	for i := 0; i < len(mutations); i += batchSize {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		var mutationSlice []store.AppStateMutationMAC
+		if len(mutations) > i+batchSize {
+			mutationSlice = mutations[i : i+batchSize]
+		} else {
+			mutationSlice = mutations[i:]
+		}
+		err := s.putAppStateMutationMACs(tx, name, version, mutationSlice)
+		if err != nil {
+			return err
+		}
+		if progress != nil {
+			progress(i/batchSize+1, totalBatches)
+		}
+	}
+	return nil
+}
 
 func (s *SQLStore) PutAppStateMutationMACs(name string, version uint64, mutations []store.AppStateMutationMAC) error {
-	if len(mutations) > mutationBatchSize {
-		tx, err := s.db.Begin()
+	return s.PutAppStateMutationMACsWithProgress(context.Background(), name, version, mutations, nil)
+}
+
+// PutAppStateMutationMACsWithProgress is like PutAppStateMutationMACs, but it stops inserting batches when the
+// context is canceled, and calls the given progress function (if non-nil) after each batch with the number of
+// batches done and the total number of batches.
+//
+// When the mutations are split into multiple batches, they're inserted in a single transaction, which is rolled
+// back if the context is canceled, so nothing is stored. However, if Container.CommitMutationBatchesSeparately
+// is enabled, batches that were already committed before cancellation are kept.
+func (s *SQLStore) PutAppStateMutationMACsWithProgress(ctx context.Context, name string, version uint64, mutations []store.AppStateMutationMAC, progress func(done, total int)) error {
+	batchSize := s.getMutationBatchSize()
+	if len(mutations) > batchSize && s.CommitMutationBatchesSeparately {
+		return s.putAppStateMutationMACBatches(ctx, s.db, name, version, mutations, batchSize, progress)
+	} else if len(mutations) > batchSize {
+		tx, err := s.db.BeginTx(ctx, nil)
 		if err != nil {
 			return fmt.Errorf("failed to start transaction: %w", err)
 		}
-		for i := 0; i < len(mutations); i += mutationBatchSize {
-			var mutationSlice []store.AppStateMutationMAC
-			if len(mutations) > i+mutationBatchSize {
-				mutationSlice = mutations[i : i+mutationBatchSize]
-			} else {
-				mutationSlice = mutations[i:]
-			}
-			err = s.putAppStateMutationMACs(tx, name, version, mutationSlice)
-			if err != nil {
-				_ = tx.Rollback()
-				return err
-			}
+		err = s.putAppStateMutationMACBatches(ctx, tx, name, version, mutations, batchSize, progress)
+		if err != nil {
+			_ = tx.Rollback()
+			return err
 		}
 		err = tx.Commit()
 		if err != nil {
@@ -403,7 +812,14 @@ func (s *SQLStore) PutAppStateMutationMACs(name string, version uint64, mutation
 		}
 		return nil
 	} else if len(mutations) > 0 {
-		return s.putAppStateMutationMACs(s.db, name, version, mutations)
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		err := s.putAppStateMutationMACs(s.db, name, version, mutations)
+		if err == nil && progress != nil {
+			progress(1, 1)
+		}
+		return err
 	}
 	return nil
 }
@@ -436,6 +852,42 @@ func (s *SQLStore) GetAppStateMutationMAC(name string, indexMAC []byte) (valueMA
 	return
 }
 
+func (s *SQLStore) GetAllAppStateValueMACs(name string) (valueMACs [][]byte, err error) {
+	pageSize := s.getMutationBatchSize()
+	cursor := []byte{}
+	for {
+		var rowCount int
+		cursor, rowCount, valueMACs, err = s.getAppStateValueMACPage(name, cursor, pageSize, valueMACs)
+		if err != nil {
+			return nil, err
+		} else if rowCount < pageSize {
+			return valueMACs, nil
+		}
+	}
+}
+
+func (s *SQLStore) getAppStateValueMACPage(name string, cursor []byte, pageSize int, valueMACs [][]byte) ([]byte, int, [][]byte, error) {
+	rows, err := s.db.Query(getAppStateValueMACPageQuery, s.JID, name, cursor, pageSize)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	defer rows.Close()
+	rowCount := 0
+	for rows.Next() {
+		var indexMAC, valueMAC []byte
+		err = rows.Scan(&indexMAC, &valueMAC)
+		if err != nil {
+			return nil, 0, nil, err
+		}
+		rowCount++
+		if !bytes.Equal(indexMAC, cursor) {
+			valueMACs = append(valueMACs, valueMAC)
+			cursor = indexMAC
+		}
+	}
+	return cursor, rowCount, valueMACs, rows.Err()
+}
+
 const (
 	putContactNameQuery = `
 		INSERT INTO whatsmeow_contacts (our_jid, their_jid, first_name, full_name) VALUES ($1, $2, $3, $4)
@@ -663,6 +1115,7 @@ const (
 	getChatSettingsQuery = `
 		SELECT muted_until, pinned, archived FROM whatsmeow_chat_settings WHERE our_jid=$1 AND chat_jid=$2
 	`
+	getChatLabelsQuery = `SELECT label_id FROM whatsmeow_chat_labels WHERE our_jid=$1 AND chat_jid=$2`
 )
 
 func (s *SQLStore) PutMutedUntil(chat types.JID, mutedUntil time.Time) error {
@@ -697,9 +1150,110 @@ func (s *SQLStore) GetChatSettings(chat types.JID) (settings types.LocalChatSett
 	if mutedUntil != 0 {
 		settings.MutedUntil = time.Unix(mutedUntil, 0)
 	}
+	rows, err := s.db.Query(getChatLabelsQuery, s.JID, chat)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var labelID string
+		err = rows.Scan(&labelID)
+		if err != nil {
+			return
+		}
+		settings.Labels = append(settings.Labels, labelID)
+		settings.Found = true
+	}
+	err = rows.Err()
+	return
+}
+
+const (
+	putLabelQuery = `
+		INSERT INTO whatsmeow_labels (our_jid, label_id, name, color, predefined_id) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (our_jid, label_id) DO UPDATE SET name=excluded.name, color=excluded.color, predefined_id=excluded.predefined_id
+	`
+	deleteLabelQuery             = `DELETE FROM whatsmeow_labels WHERE our_jid=$1 AND label_id=$2`
+	deleteLabelAssociationsQuery = `DELETE FROM whatsmeow_chat_labels WHERE our_jid=$1 AND label_id=$2`
+	getLabelsQuery               = `SELECT label_id, name, color, predefined_id FROM whatsmeow_labels WHERE our_jid=$1`
+	putChatLabelQuery            = `
+		INSERT INTO whatsmeow_chat_labels (our_jid, chat_jid, label_id) VALUES ($1, $2, $3)
+		ON CONFLICT (our_jid, chat_jid, label_id) DO NOTHING
+	`
+	deleteChatLabelQuery = `DELETE FROM whatsmeow_chat_labels WHERE our_jid=$1 AND chat_jid=$2 AND label_id=$3`
+	getLabelChatsQuery   = `SELECT chat_jid FROM whatsmeow_chat_labels WHERE our_jid=$1 AND label_id=$2`
+)
+
+func (s *SQLStore) PutLabel(label types.Label) error {
+	_, err := s.db.Exec(putLabelQuery, s.JID, label.ID, label.Name, label.Color, label.PredefinedID)
+	return err
+}
+
+func (s *SQLStore) DeleteLabel(id string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	_, err = tx.Exec(deleteLabelAssociationsQuery, s.JID, id)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to delete label associations: %w", err)
+	}
+	_, err = tx.Exec(deleteLabelQuery, s.JID, id)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to delete label: %w", err)
+	}
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (s *SQLStore) GetLabels() (labels []types.Label, err error) {
+	rows, err := s.db.Query(getLabelsQuery, s.JID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var label types.Label
+		err = rows.Scan(&label.ID, &label.Name, &label.Color, &label.PredefinedID)
+		if err != nil {
+			return nil, err
+		}
+		labels = append(labels, label)
+	}
+	return labels, rows.Err()
+}
+
+func (s *SQLStore) PutChatLabel(chat types.JID, labelID string, labeled bool) (err error) {
+	if labeled {
+		_, err = s.db.Exec(putChatLabelQuery, s.JID, chat, labelID)
+	} else {
+		_, err = s.db.Exec(deleteChatLabelQuery, s.JID, chat, labelID)
+	}
 	return
 }
 
+func (s *SQLStore) GetLabelChats(labelID string) (chats []types.JID, err error) {
+	rows, err := s.db.Query(getLabelChatsQuery, s.JID, labelID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var chat types.JID
+		err = rows.Scan(&chat)
+		if err != nil {
+			return nil, err
+		}
+		chats = append(chats, chat)
+	}
+	return chats, rows.Err()
+}
+
 const (
 	putMsgSecret = `
 		INSERT INTO whatsmeow_message_secrets (our_jid, chat_jid, sender_jid, message_id, key)
@@ -718,6 +1272,10 @@ func (s *SQLStore) PutMessageSecrets(inserts []store.MessageSecretInsert) (err e
 	}
 	for _, insert := range inserts {
 		_, err = tx.Exec(putMsgSecret, s.JID, insert.Chat.ToNonAD(), insert.Sender.ToNonAD(), insert.ID, insert.Secret)
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to insert message secret for %s: %w", insert.ID, err)
+		}
 	}
 	err = tx.Commit()
 	if err != nil {
@@ -777,3 +1335,347 @@ func (s *SQLStore) GetPrivacyToken(user types.JID) (*store.PrivacyToken, error)
 		return &token, nil
 	}
 }
+
+const (
+	putPollOptionQuery = `
+		INSERT INTO whatsmeow_poll_options (our_jid, chat_jid, sender_jid, poll_id, option_index, option_name)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (our_jid, chat_jid, sender_jid, poll_id, option_index) DO NOTHING
+	`
+	getPollOptionsQuery = `
+		SELECT option_name FROM whatsmeow_poll_options
+		WHERE our_jid=$1 AND chat_jid=$2 AND sender_jid=$3 AND poll_id=$4
+		ORDER BY option_index
+	`
+	putPollVoteQuery = `
+		INSERT INTO whatsmeow_poll_votes (our_jid, chat_jid, sender_jid, poll_id, voter_jid, selected_options, timestamp)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (our_jid, chat_jid, sender_jid, poll_id, voter_jid) DO UPDATE
+			SET selected_options=excluded.selected_options, timestamp=excluded.timestamp
+			WHERE excluded.timestamp >= whatsmeow_poll_votes.timestamp
+	`
+	getPollVotesQuery = `
+		SELECT voter_jid, selected_options, timestamp FROM whatsmeow_poll_votes
+		WHERE our_jid=$1 AND chat_jid=$2 AND sender_jid=$3 AND poll_id=$4
+	`
+)
+
+func (s *SQLStore) PutPollOptions(chat, sender types.JID, id types.MessageID, options []string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	for i, option := range options {
+		_, err = tx.Exec(putPollOptionQuery, s.JID, chat.ToNonAD(), sender.ToNonAD(), id, i, option)
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to insert option #%d: %w", i+1, err)
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (s *SQLStore) GetPollOptions(chat, sender types.JID, id types.MessageID) (options []string, err error) {
+	rows, err := s.db.Query(getPollOptionsQuery, s.JID, chat.ToNonAD(), sender.ToNonAD(), id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var option string
+		err = rows.Scan(&option)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, option)
+	}
+	return options, rows.Err()
+}
+
+func (s *SQLStore) PutPollVote(chat, sender types.JID, id types.MessageID, vote store.PollVote) error {
+	selected := make([]byte, 0, len(vote.SelectedOptions)*32)
+	for _, hash := range vote.SelectedOptions {
+		if len(hash) != 32 {
+			return ErrInvalidLength
+		}
+		selected = append(selected, hash...)
+	}
+	_, err := s.db.Exec(putPollVoteQuery, s.JID, chat.ToNonAD(), sender.ToNonAD(), id, vote.Voter.ToNonAD(), selected, vote.Timestamp.UnixMilli())
+	return err
+}
+
+func (s *SQLStore) GetPollVotes(chat, sender types.JID, id types.MessageID) (votes []store.PollVote, err error) {
+	rows, err := s.db.Query(getPollVotesQuery, s.JID, chat.ToNonAD(), sender.ToNonAD(), id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var vote store.PollVote
+		var selected []byte
+		var ts int64
+		err = rows.Scan(&vote.Voter, &selected, &ts)
+		if err != nil {
+			return nil, err
+		} else if len(selected)%32 != 0 {
+			return nil, ErrInvalidLength
+		}
+		for i := 0; i < len(selected); i += 32 {
+			vote.SelectedOptions = append(vote.SelectedOptions, selected[i:i+32])
+		}
+		vote.Timestamp = time.UnixMilli(ts)
+		votes = append(votes, vote)
+	}
+	return votes, rows.Err()
+}
+
+const (
+	putMessageReceiptQuery = `
+		INSERT INTO whatsmeow_message_receipts (our_jid, chat_jid, message_id, receiver_jid, delivered_at, read_at, played_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (our_jid, chat_jid, message_id, receiver_jid) DO UPDATE
+			SET delivered_at=COALESCE(whatsmeow_message_receipts.delivered_at, excluded.delivered_at),
+				read_at=COALESCE(whatsmeow_message_receipts.read_at, excluded.read_at),
+				played_at=COALESCE(whatsmeow_message_receipts.played_at, excluded.played_at)
+	`
+	getMessageReceiptsQuery = `
+		SELECT receiver_jid, delivered_at, read_at, played_at FROM whatsmeow_message_receipts
+		WHERE our_jid=$1 AND chat_jid=$2 AND message_id=$3
+	`
+	pruneMessageReceiptsQuery = `
+		DELETE FROM whatsmeow_message_receipts
+		WHERE our_jid=$1 AND COALESCE(played_at, read_at, delivered_at, 0) < $2
+	`
+)
+
+func nullableUnixMilli(ts time.Time) sql.NullInt64 {
+	return sql.NullInt64{Int64: ts.UnixMilli(), Valid: !ts.IsZero()}
+}
+
+func fromNullableUnixMilli(ts sql.NullInt64) time.Time {
+	if !ts.Valid {
+		return time.Time{}
+	}
+	return time.UnixMilli(ts.Int64)
+}
+
+func (s *SQLStore) PutMessageReceipts(chat types.JID, ids []types.MessageID, receipts []store.MessageReceipt) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	for _, receipt := range receipts {
+		deliveredAt := nullableUnixMilli(receipt.DeliveredAt)
+		readAt := nullableUnixMilli(receipt.ReadAt)
+		playedAt := nullableUnixMilli(receipt.PlayedAt)
+		for _, id := range ids {
+			_, err = tx.Exec(putMessageReceiptQuery, s.JID, chat.ToNonAD(), id, receipt.Receiver.ToNonAD(), deliveredAt, readAt, playedAt)
+			if err != nil {
+				_ = tx.Rollback()
+				return fmt.Errorf("failed to insert receipt for %s from %s: %w", id, receipt.Receiver, err)
+			}
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (s *SQLStore) PruneMessageReceipts(olderThan time.Time) (int64, error) {
+	res, err := s.db.Exec(pruneMessageReceiptsQuery, s.JID, olderThan.UnixMilli())
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+func (s *SQLStore) GetMessageReceipts(chat types.JID, id types.MessageID) (receipts []store.MessageReceipt, err error) {
+	rows, err := s.db.Query(getMessageReceiptsQuery, s.JID, chat.ToNonAD(), id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var receipt store.MessageReceipt
+		var deliveredAt, readAt, playedAt sql.NullInt64
+		err = rows.Scan(&receipt.Receiver, &deliveredAt, &readAt, &playedAt)
+		if err != nil {
+			return nil, err
+		}
+		receipt.DeliveredAt = fromNullableUnixMilli(deliveredAt)
+		receipt.ReadAt = fromNullableUnixMilli(readAt)
+		receipt.PlayedAt = fromNullableUnixMilli(playedAt)
+		receipts = append(receipts, receipt)
+	}
+	return receipts, rows.Err()
+}
+
+const (
+	putStarredMessageQuery = `
+		INSERT INTO whatsmeow_starred_messages (our_jid, chat_jid, message_id, sender_jid, from_me, starred_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (our_jid, chat_jid, message_id) DO UPDATE
+			SET sender_jid=excluded.sender_jid, from_me=excluded.from_me, starred_at=excluded.starred_at
+	`
+	deleteStarredMessageQuery = `DELETE FROM whatsmeow_starred_messages WHERE our_jid=$1 AND chat_jid=$2 AND message_id=$3`
+	isMessageStarredQuery     = `SELECT EXISTS(SELECT 1 FROM whatsmeow_starred_messages WHERE our_jid=$1 AND chat_jid=$2 AND message_id=$3)`
+	getStarredMessagesQuery   = `
+		SELECT chat_jid, message_id, sender_jid, from_me, starred_at FROM whatsmeow_starred_messages
+		WHERE our_jid=$1 ORDER BY starred_at DESC
+	`
+)
+
+func (s *SQLStore) PutStarredMessage(msg store.StarredMessage, starred bool) (err error) {
+	if starred {
+		_, err = s.db.Exec(putStarredMessageQuery, s.JID, msg.Chat.ToNonAD(), msg.MessageID, msg.Sender.ToNonAD().String(), msg.IsFromMe, msg.Timestamp.UnixMilli())
+	} else {
+		_, err = s.db.Exec(deleteStarredMessageQuery, s.JID, msg.Chat.ToNonAD(), msg.MessageID)
+	}
+	return
+}
+
+func (s *SQLStore) IsMessageStarred(chat types.JID, id types.MessageID) (starred bool, err error) {
+	err = s.db.QueryRow(isMessageStarredQuery, s.JID, chat.ToNonAD(), id).Scan(&starred)
+	return
+}
+
+func (s *SQLStore) GetStarredMessages() (msgs []store.StarredMessage, err error) {
+	rows, err := s.db.Query(getStarredMessagesQuery, s.JID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var msg store.StarredMessage
+		var sender string
+		var ts int64
+		err = rows.Scan(&msg.Chat, &msg.MessageID, &sender, &msg.IsFromMe, &ts)
+		if err != nil {
+			return nil, err
+		}
+		if sender != "" {
+			msg.Sender, err = types.ParseJID(sender)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse sender JID: %w", err)
+			}
+		}
+		msg.Timestamp = time.UnixMilli(ts)
+		msgs = append(msgs, msg)
+	}
+	return msgs, rows.Err()
+}
+
+const (
+	putLIDMappingQuery = `
+		INSERT INTO whatsmeow_lid_map (our_jid, lid, pn) VALUES ($1, $2, $3)
+		ON CONFLICT (our_jid, lid) DO UPDATE SET pn=excluded.pn
+	`
+	getPNForLIDQuery = `SELECT pn FROM whatsmeow_lid_map WHERE our_jid=$1 AND lid=$2`
+	getLIDForPNQuery = `SELECT lid FROM whatsmeow_lid_map WHERE our_jid=$1 AND pn=$2 LIMIT 1`
+)
+
+func (s *SQLStore) PutLIDMapping(lid, pn types.JID) error {
+	_, err := s.db.Exec(putLIDMappingQuery, s.JID, lid.ToNonAD(), pn.ToNonAD())
+	return err
+}
+
+func (s *SQLStore) GetPNForLID(lid types.JID) (pn types.JID, err error) {
+	err = s.db.QueryRow(getPNForLIDQuery, s.JID, lid.ToNonAD()).Scan(&pn)
+	if errors.Is(err, sql.ErrNoRows) {
+		err = nil
+	}
+	return
+}
+
+func (s *SQLStore) GetLIDForPN(pn types.JID) (lid types.JID, err error) {
+	err = s.db.QueryRow(getLIDForPNQuery, s.JID, pn.ToNonAD()).Scan(&lid)
+	if errors.Is(err, sql.ErrNoRows) {
+		err = nil
+	}
+	return
+}
+
+const (
+	putLastMessageQuery = `
+		INSERT INTO whatsmeow_last_messages (our_jid, chat_jid, message_id, timestamp) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (our_jid, chat_jid) DO UPDATE SET message_id=excluded.message_id, timestamp=excluded.timestamp
+		WHERE excluded.timestamp >= whatsmeow_last_messages.timestamp
+	`
+	getLastMessageQuery     = `SELECT chat_jid, message_id, timestamp FROM whatsmeow_last_messages WHERE our_jid=$1 AND chat_jid=$2`
+	getAllLastMessagesQuery = `SELECT chat_jid, message_id, timestamp FROM whatsmeow_last_messages WHERE our_jid=$1`
+)
+
+func (s *SQLStore) PutLastMessage(chat types.JID, id types.MessageID, timestamp time.Time) error {
+	_, err := s.db.Exec(putLastMessageQuery, s.JID, chat.ToNonAD(), id, timestamp.UnixMilli())
+	return err
+}
+
+func scanLastMessage(row scannable) (*store.LastMessage, error) {
+	var msg store.LastMessage
+	var ts int64
+	err := row.Scan(&msg.Chat, &msg.MessageID, &ts)
+	if err != nil {
+		return nil, err
+	}
+	msg.Timestamp = time.UnixMilli(ts)
+	return &msg, nil
+}
+
+func (s *SQLStore) GetLastMessage(chat types.JID) (*store.LastMessage, error) {
+	msg, err := scanLastMessage(s.db.QueryRow(getLastMessageQuery, s.JID, chat.ToNonAD()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return msg, err
+}
+
+func (s *SQLStore) GetAllLastMessages() (msgs []store.LastMessage, err error) {
+	rows, err := s.db.Query(getAllLastMessagesQuery, s.JID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		msg, err := scanLastMessage(rows)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, *msg)
+	}
+	return msgs, rows.Err()
+}
+
+const (
+	putVerifiedIdentityQuery = `
+		INSERT INTO whatsmeow_verified_identities (our_jid, their_id, identity) VALUES ($1, $2, $3)
+		ON CONFLICT (our_jid, their_id) DO UPDATE SET identity=excluded.identity
+	`
+	deleteVerifiedIdentityQuery = `DELETE FROM whatsmeow_verified_identities WHERE our_jid=$1 AND their_id=$2`
+	getVerifiedIdentityQuery    = `SELECT identity FROM whatsmeow_verified_identities WHERE our_jid=$1 AND their_id=$2`
+)
+
+func (s *SQLStore) PutVerifiedIdentity(address string, key [32]byte, verified bool) (err error) {
+	if verified {
+		_, err = s.db.Exec(putVerifiedIdentityQuery, s.JID, address, key[:])
+	} else {
+		_, err = s.db.Exec(deleteVerifiedIdentityQuery, s.JID, address)
+	}
+	return
+}
+
+func (s *SQLStore) IsIdentityVerified(address string, key [32]byte) (bool, error) {
+	var verifiedKey []byte
+	err := s.db.QueryRow(getVerifiedIdentityQuery, s.JID, address).Scan(&verifiedKey)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return bytes.Equal(verifiedKey, key[:]), nil
+}
@@ -17,7 +17,7 @@ type upgradeFunc func(*sql.Tx, *Container) error
 //
 // This may be of use if you want to manage the database fully manually, but in most cases you
 // should just call Container.Upgrade to let the library handle everything.
-var Upgrades = [...]upgradeFunc{upgradeV1, upgradeV2, upgradeV3, upgradeV4, upgradeV5, upgradeV6}
+var Upgrades = [...]upgradeFunc{upgradeV1, upgradeV2, upgradeV3, upgradeV4, upgradeV5, upgradeV6, upgradeV7, upgradeV8, upgradeV9, upgradeV10, upgradeV11, upgradeV12, upgradeV13, upgradeV14, upgradeV15}
 
 func (c *Container) getVersion() (int, error) {
 	_, err := c.db.Exec("CREATE TABLE IF NOT EXISTS whatsmeow_version (version INTEGER)")
@@ -292,3 +292,147 @@ func upgradeV6(tx *sql.Tx, container *Container) error {
 	_, err := tx.Exec("ALTER TABLE whatsmeow_device ADD COLUMN facebook_uuid uuid")
 	return err
 }
+
+func upgradeV7(tx *sql.Tx, container *Container) error {
+	_, err := tx.Exec(`CREATE TABLE whatsmeow_sender_key_shared (
+		our_jid   TEXT,
+		chat_id   TEXT,
+		device_id TEXT,
+
+		PRIMARY KEY (our_jid, chat_id, device_id),
+		FOREIGN KEY (our_jid) REFERENCES whatsmeow_device(jid) ON DELETE CASCADE ON UPDATE CASCADE
+	)`)
+	return err
+}
+
+func upgradeV8(tx *sql.Tx, container *Container) error {
+	_, err := tx.Exec(`CREATE TABLE whatsmeow_poll_options (
+		our_jid      TEXT,
+		chat_jid     TEXT,
+		sender_jid   TEXT,
+		poll_id      TEXT,
+		option_index INTEGER,
+		option_name  TEXT NOT NULL,
+
+		PRIMARY KEY (our_jid, chat_jid, sender_jid, poll_id, option_index),
+		FOREIGN KEY (our_jid) REFERENCES whatsmeow_device(jid) ON DELETE CASCADE ON UPDATE CASCADE
+	)`)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(`CREATE TABLE whatsmeow_poll_votes (
+		our_jid          TEXT,
+		chat_jid         TEXT,
+		sender_jid       TEXT,
+		poll_id          TEXT,
+		voter_jid        TEXT,
+		selected_options bytea  NOT NULL,
+		timestamp        BIGINT NOT NULL,
+
+		PRIMARY KEY (our_jid, chat_jid, sender_jid, poll_id, voter_jid),
+		FOREIGN KEY (our_jid) REFERENCES whatsmeow_device(jid) ON DELETE CASCADE ON UPDATE CASCADE
+	)`)
+	return err
+}
+
+func upgradeV9(tx *sql.Tx, container *Container) error {
+	_, err := tx.Exec("ALTER TABLE whatsmeow_device ADD COLUMN default_disappearing_timer BIGINT NOT NULL DEFAULT 0")
+	return err
+}
+
+func upgradeV10(tx *sql.Tx, container *Container) error {
+	_, err := tx.Exec(`CREATE TABLE whatsmeow_message_receipts (
+		our_jid      TEXT,
+		chat_jid     TEXT,
+		message_id   TEXT,
+		receiver_jid TEXT,
+		delivered_at BIGINT,
+		read_at      BIGINT,
+		played_at    BIGINT,
+
+		PRIMARY KEY (our_jid, chat_jid, message_id, receiver_jid),
+		FOREIGN KEY (our_jid) REFERENCES whatsmeow_device(jid) ON DELETE CASCADE ON UPDATE CASCADE
+	)`)
+	return err
+}
+
+func upgradeV11(tx *sql.Tx, container *Container) error {
+	_, err := tx.Exec(`CREATE TABLE whatsmeow_labels (
+		our_jid       TEXT,
+		label_id      TEXT,
+		name          TEXT    NOT NULL,
+		color         INTEGER NOT NULL,
+		predefined_id INTEGER NOT NULL,
+
+		PRIMARY KEY (our_jid, label_id),
+		FOREIGN KEY (our_jid) REFERENCES whatsmeow_device(jid) ON DELETE CASCADE ON UPDATE CASCADE
+	)`)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(`CREATE TABLE whatsmeow_chat_labels (
+		our_jid  TEXT,
+		chat_jid TEXT,
+		label_id TEXT,
+
+		PRIMARY KEY (our_jid, chat_jid, label_id),
+		FOREIGN KEY (our_jid) REFERENCES whatsmeow_device(jid) ON DELETE CASCADE ON UPDATE CASCADE
+	)`)
+	return err
+}
+
+func upgradeV12(tx *sql.Tx, container *Container) error {
+	_, err := tx.Exec(`CREATE TABLE whatsmeow_starred_messages (
+		our_jid    TEXT,
+		chat_jid   TEXT,
+		message_id TEXT,
+		sender_jid TEXT    NOT NULL,
+		from_me    BOOLEAN NOT NULL,
+		starred_at BIGINT  NOT NULL,
+
+		PRIMARY KEY (our_jid, chat_jid, message_id),
+		FOREIGN KEY (our_jid) REFERENCES whatsmeow_device(jid) ON DELETE CASCADE ON UPDATE CASCADE
+	)`)
+	return err
+}
+
+func upgradeV13(tx *sql.Tx, container *Container) error {
+	_, err := tx.Exec("ALTER TABLE whatsmeow_device ADD COLUMN lid TEXT")
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(`CREATE TABLE whatsmeow_lid_map (
+		our_jid TEXT,
+		lid     TEXT,
+		pn      TEXT NOT NULL,
+
+		PRIMARY KEY (our_jid, lid),
+		FOREIGN KEY (our_jid) REFERENCES whatsmeow_device(jid) ON DELETE CASCADE ON UPDATE CASCADE
+	)`)
+	return err
+}
+
+func upgradeV14(tx *sql.Tx, container *Container) error {
+	_, err := tx.Exec(`CREATE TABLE whatsmeow_last_messages (
+		our_jid    TEXT,
+		chat_jid   TEXT,
+		message_id TEXT   NOT NULL,
+		timestamp  BIGINT NOT NULL,
+
+		PRIMARY KEY (our_jid, chat_jid),
+		FOREIGN KEY (our_jid) REFERENCES whatsmeow_device(jid) ON DELETE CASCADE ON UPDATE CASCADE
+	)`)
+	return err
+}
+
+func upgradeV15(tx *sql.Tx, container *Container) error {
+	_, err := tx.Exec(`CREATE TABLE whatsmeow_verified_identities (
+		our_jid  TEXT,
+		their_id TEXT,
+		identity bytea NOT NULL CHECK ( length(identity) = 32 ),
+
+		PRIMARY KEY (our_jid, their_id),
+		FOREIGN KEY (our_jid) REFERENCES whatsmeow_device(jid) ON DELETE CASCADE ON UPDATE CASCADE
+	)`)
+	return err
+}
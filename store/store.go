@@ -21,6 +21,7 @@ import (
 
 type IdentityStore interface {
 	PutIdentity(address string, key [32]byte) error
+	GetIdentity(address string) ([]byte, error)
 	DeleteAllIdentities(phone string) error
 	DeleteIdentity(address string) error
 	IsTrustedIdentity(address string, key [32]byte) (bool, error)
@@ -30,7 +31,11 @@ type SessionStore interface {
 	GetSession(address string) ([]byte, error)
 	HasSession(address string) (bool, error)
 	PutSession(address string, session []byte) error
+	// DeleteAllSessions deletes the sessions of all devices of the given user. Like DeleteSession,
+	// this should also forget which groups' sender keys have been shared with those devices.
 	DeleteAllSessions(phone string) error
+	// DeleteSession deletes the session with the given device. Stores that implement SenderKeyStore should also
+	// forget that the device has received any sender keys, as a new session means it must receive them again.
 	DeleteSession(address string) error
 }
 
@@ -46,6 +51,14 @@ type PreKeyStore interface {
 type SenderKeyStore interface {
 	PutSenderKey(group, user string, session []byte) error
 	GetSenderKey(group, user string) ([]byte, error)
+	DeleteSenderKey(group, user string) error
+
+	// GetSenderKeySharedWith returns the addresses of devices that have received our current sender key for the group.
+	GetSenderKeySharedWith(group string) (map[string]struct{}, error)
+	// MarkSenderKeyShared stores that the given devices have received our current sender key for the group.
+	MarkSenderKeyShared(group string, addresses []string) error
+	// ResetSenderKeyShared forgets which devices have received our sender key for the group.
+	ResetSenderKeyShared(group string) error
 }
 
 type AppStateSyncKey struct {
@@ -55,9 +68,16 @@ type AppStateSyncKey struct {
 }
 
 type AppStateSyncKeyStore interface {
+	// PutAppStateSyncKey stores the given key. If a key with the same ID is already stored, it must only be
+	// replaced if the new key has a newer timestamp, so that out-of-order key shares can't overwrite newer keys.
 	PutAppStateSyncKey(id []byte, key AppStateSyncKey) error
+	// GetAppStateSyncKey returns the stored key with the given ID, or nil if it isn't found.
 	GetAppStateSyncKey(id []byte) (*AppStateSyncKey, error)
+	// GetLatestAppStateSyncKeyID returns the ID of the key with the newest timestamp, or nil if there are no keys.
 	GetLatestAppStateSyncKeyID() ([]byte, error)
+	// GetAllAppStateSyncKeyIDs returns the IDs of all stored keys, newest first. This is mostly useful for debugging
+	// app state decryption failures (appstate.ErrKeyNotFound).
+	GetAllAppStateSyncKeyIDs() ([][]byte, error)
 }
 
 type AppStateMutationMAC struct {
@@ -68,11 +88,13 @@ type AppStateMutationMAC struct {
 type AppStateStore interface {
 	PutAppStateVersion(name string, version uint64, hash [128]byte) error
 	GetAppStateVersion(name string) (uint64, [128]byte, error)
+	GetAppStateVersions() (map[string]uint64, error)
 	DeleteAppStateVersion(name string) error
 
 	PutAppStateMutationMACs(name string, version uint64, mutations []AppStateMutationMAC) error
 	DeleteAppStateMutationMACs(name string, indexMACs [][]byte) error
 	GetAppStateMutationMAC(name string, indexMAC []byte) (valueMAC []byte, err error)
+	GetAllAppStateValueMACs(name string) (valueMACs [][]byte, err error)
 }
 
 type ContactEntry struct {
@@ -97,6 +119,62 @@ type ChatSettingsStore interface {
 	GetChatSettings(chat types.JID) (types.LocalChatSettings, error)
 }
 
+// StarredMessage contains the key of a message that the user has starred.
+type StarredMessage struct {
+	Chat      types.JID
+	Sender    types.JID
+	MessageID types.MessageID
+	IsFromMe  bool
+	Timestamp time.Time
+}
+
+type StarredMessageStore interface {
+	PutStarredMessage(msg StarredMessage, starred bool) error
+	IsMessageStarred(chat types.JID, id types.MessageID) (bool, error)
+	GetStarredMessages() ([]StarredMessage, error)
+}
+
+// LIDStore stores mappings between phone number JIDs and the hidden user IDs (LIDs) of the same users.
+type LIDStore interface {
+	PutLIDMapping(lid, pn types.JID) error
+	// GetPNForLID returns the phone number JID of the given LID, or an empty JID if the mapping isn't known.
+	GetPNForLID(lid types.JID) (types.JID, error)
+	// GetLIDForPN returns the LID of the given phone number JID, or an empty JID if the mapping isn't known.
+	GetLIDForPN(pn types.JID) (types.JID, error)
+}
+
+// LastMessage is the newest message that has been received in a chat.
+type LastMessage struct {
+	Chat      types.JID
+	MessageID types.MessageID
+	Timestamp time.Time
+}
+
+type LastMessageStore interface {
+	// PutLastMessage stores the given message as the last message of the chat, unless a newer message is already stored.
+	PutLastMessage(chat types.JID, id types.MessageID, timestamp time.Time) error
+	// GetLastMessage returns the last message of the chat, or nil if no messages have been stored for the chat.
+	GetLastMessage(chat types.JID) (*LastMessage, error)
+	GetAllLastMessages() ([]LastMessage, error)
+}
+
+// VerifiedIdentityStore stores the identity keys that the user has verified out of band, e.g. by comparing security codes.
+type VerifiedIdentityStore interface {
+	// PutVerifiedIdentity marks the identity key of the given address as verified, or removes the mark if verified is false.
+	PutVerifiedIdentity(address string, key [32]byte, verified bool) error
+	// IsIdentityVerified returns true if the given identity key has been marked as verified for the address.
+	// Marks are bound to the key, so they don't apply anymore after the identity of the address changes.
+	IsIdentityVerified(address string, key [32]byte) (bool, error)
+}
+
+type LabelStore interface {
+	PutLabel(label types.Label) error
+	DeleteLabel(id string) error
+	GetLabels() ([]types.Label, error)
+	PutChatLabel(chat types.JID, labelID string, labeled bool) error
+	GetLabelChats(labelID string) ([]types.JID, error)
+}
+
 type DeviceContainer interface {
 	PutDevice(store *Device) error
 	DeleteDevice(store *Device) error
@@ -109,9 +187,12 @@ type MessageSecretInsert struct {
 	Secret []byte
 }
 
+// MsgSecretStore stores the per-message secrets that are used to encrypt things like poll votes and reactions
+// that refer to the original message.
 type MsgSecretStore interface {
 	PutMessageSecrets([]MessageSecretInsert) error
 	PutMessageSecret(chat, sender types.JID, id types.MessageID, secret []byte) error
+	// GetMessageSecret returns the secret of the given message, or nil if it isn't known.
 	GetMessageSecret(chat, sender types.JID, id types.MessageID) ([]byte, error)
 }
 
@@ -126,6 +207,39 @@ type PrivacyTokenStore interface {
 	GetPrivacyToken(user types.JID) (*PrivacyToken, error)
 }
 
+// PollVote is the latest vote of a single user in a poll.
+type PollVote struct {
+	Voter types.JID
+	// SHA-256 hashes of the selected option names.
+	SelectedOptions [][]byte
+	Timestamp       time.Time
+}
+
+type PollStore interface {
+	PutPollOptions(chat, sender types.JID, id types.MessageID, options []string) error
+	GetPollOptions(chat, sender types.JID, id types.MessageID) ([]string, error)
+	PutPollVote(chat, sender types.JID, id types.MessageID, vote PollVote) error
+	GetPollVotes(chat, sender types.JID, id types.MessageID) ([]PollVote, error)
+}
+
+// MessageReceipt contains the receipts that a single user has sent for a message.
+// The timestamps are zero if the corresponding receipt hasn't been received.
+type MessageReceipt struct {
+	Receiver    types.JID
+	DeliveredAt time.Time
+	ReadAt      time.Time
+	PlayedAt    time.Time
+}
+
+type MessageReceiptStore interface {
+	// PutMessageReceipts merges each of the given receipts with the stored ones of all the given messages.
+	// Timestamps that are already stored are not overwritten.
+	PutMessageReceipts(chat types.JID, ids []types.MessageID, receipts []MessageReceipt) error
+	GetMessageReceipts(chat types.JID, id types.MessageID) ([]MessageReceipt, error)
+	// PruneMessageReceipts deletes the receipts of all messages whose latest receipt is older than the given time.
+	PruneMessageReceipts(olderThan time.Time) (int64, error)
+}
+
 type Device struct {
 	Log waLog.Logger
 
@@ -136,6 +250,7 @@ type Device struct {
 	AdvSecretKey   []byte
 
 	ID           *types.JID
+	LID          types.JID // The hidden user ID of the account, used instead of the phone number in LID-addressed groups.
 	Account      *waProto.ADVSignedDeviceIdentity
 	Platform     string
 	BusinessName string
@@ -143,6 +258,9 @@ type Device struct {
 
 	FacebookUUID uuid.UUID
 
+	// DefaultDisappearingTimer is the account-wide disappearing message timer that is applied to new chats.
+	DefaultDisappearingTimer time.Duration
+
 	Initialized   bool
 	Identities    IdentityStore
 	Sessions      SessionStore
@@ -154,8 +272,16 @@ type Device struct {
 	ChatSettings  ChatSettingsStore
 	MsgSecrets    MsgSecretStore
 	PrivacyTokens PrivacyTokenStore
+	Polls         PollStore
+	Receipts      MessageReceiptStore
+	Labels        LabelStore
+	Stars         StarredMessageStore
+	LIDs          LIDStore
+	LastMessages  LastMessageStore
 	Container     DeviceContainer
 
+	VerifiedIdentities VerifiedIdentityStore
+
 	DatabaseErrorHandler func(device *Device, action string, attemptIndex int, err error) (retry bool)
 }
 
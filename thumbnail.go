@@ -0,0 +1,129 @@
+// Copyright (c) 2022 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+)
+
+// ThumbnailMaxSize is the maximum width and height of the JPEG thumbnails generated for media messages.
+const ThumbnailMaxSize = 72
+
+// ThumbnailJPEGQuality is the JPEG quality used when encoding generated thumbnails.
+const ThumbnailJPEGQuality = 75
+
+// VideoFrameExtractor is a function that extracts a single frame from a video file to be used as the thumbnail.
+//
+// Extracting frames requires decoding the video, which can't reasonably be done in pure Go,
+// so the implementation is left to the user (e.g. by calling ffmpeg).
+type VideoFrameExtractor func(ctx context.Context, video []byte) (image.Image, error)
+
+// downscaleImage shrinks the given image so that it fits in a maxSize x maxSize box by averaging the source pixels.
+func downscaleImage(img image.Image, maxSize int) image.Image {
+	bounds := img.Bounds()
+	srcWidth, srcHeight := bounds.Dx(), bounds.Dy()
+	dstWidth, dstHeight := srcWidth, srcHeight
+	if srcWidth > maxSize || srcHeight > maxSize {
+		if srcWidth > srcHeight {
+			dstWidth = maxSize
+			dstHeight = max(srcHeight*maxSize/srcWidth, 1)
+		} else {
+			dstHeight = maxSize
+			dstWidth = max(srcWidth*maxSize/srcHeight, 1)
+		}
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+	for y := 0; y < dstHeight; y++ {
+		srcY0 := bounds.Min.Y + y*srcHeight/dstHeight
+		srcY1 := max(bounds.Min.Y+(y+1)*srcHeight/dstHeight, srcY0+1)
+		for x := 0; x < dstWidth; x++ {
+			srcX0 := bounds.Min.X + x*srcWidth/dstWidth
+			srcX1 := max(bounds.Min.X+(x+1)*srcWidth/dstWidth, srcX0+1)
+			var r, g, b, a, count uint64
+			for sy := srcY0; sy < srcY1; sy++ {
+				for sx := srcX0; sx < srcX1; sx++ {
+					pr, pg, pb, pa := img.At(sx, sy).RGBA()
+					r += uint64(pr)
+					g += uint64(pg)
+					b += uint64(pb)
+					a += uint64(pa)
+					count++
+				}
+			}
+			dst.Set(x, y, color.RGBA64{
+				R: uint16(r / count),
+				G: uint16(g / count),
+				B: uint16(b / count),
+				A: uint16(a / count),
+			})
+		}
+	}
+	return dst
+}
+
+// GenerateThumbnail downscales the given image and encodes it as a JPEG that can be used as the JpegThumbnail
+// in media messages. The returned width and height are the dimensions of the original image.
+func GenerateThumbnail(img image.Image) (thumbnail []byte, width, height int, err error) {
+	var buf bytes.Buffer
+	err = jpeg.Encode(&buf, downscaleImage(img, ThumbnailMaxSize), &jpeg.Options{Quality: ThumbnailJPEGQuality})
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("failed to encode thumbnail: %w", err)
+	}
+	return buf.Bytes(), img.Bounds().Dx(), img.Bounds().Dy(), nil
+}
+
+// AddImageThumbnail decodes the given image data (JPEG, PNG or GIF) and fills the JpegThumbnail,
+// Width and Height fields of the given image message.
+//
+// ErrInvalidImageFormat is returned if the data can't be decoded.
+func AddImageThumbnail(msg *waProto.ImageMessage, data []byte) error {
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidImageFormat, err)
+	}
+	thumbnail, width, height, err := GenerateThumbnail(img)
+	if err != nil {
+		return err
+	}
+	msg.JpegThumbnail = thumbnail
+	msg.Width = proto.Uint32(uint32(width))
+	msg.Height = proto.Uint32(uint32(height))
+	return nil
+}
+
+// AddVideoThumbnail extracts a frame from the given video using Client.VideoFrameExtractor and fills the
+// JpegThumbnail, Width and Height fields of the given video message.
+//
+// ErrNoVideoFrameExtractor is returned if Client.VideoFrameExtractor is not set.
+func (cli *Client) AddVideoThumbnail(ctx context.Context, msg *waProto.VideoMessage, data []byte) error {
+	if cli.VideoFrameExtractor == nil {
+		return ErrNoVideoFrameExtractor
+	}
+	frame, err := cli.VideoFrameExtractor(ctx, data)
+	if err != nil {
+		return fmt.Errorf("failed to extract video frame: %w", err)
+	}
+	thumbnail, width, height, err := GenerateThumbnail(frame)
+	if err != nil {
+		return err
+	}
+	msg.JpegThumbnail = thumbnail
+	msg.Width = proto.Uint32(uint32(width))
+	msg.Height = proto.Uint32(uint32(height))
+	return nil
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2022 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package types
+
+// Product contains info about a product in a WhatsApp business catalog.
+//
+// Prices are in thousandths of the currency unit (e.g. 12.50 USD is 12500), like in the product message protobufs.
+type Product struct {
+	ID          string
+	RetailerID  string
+	Name        string
+	Description string
+	URL         string
+	ImageURL    string
+
+	Currency      string
+	Price1000     int64
+	SalePrice1000 int64
+	IsHidden      bool
+	Availability  string
+	BusinessJID   JID
+}
+
+// ProductCatalog contains a page of products in a WhatsApp business catalog (see Client.GetCatalog).
+type ProductCatalog struct {
+	Products []Product
+	// The cursor for fetching the next page of products. Empty if there are no more products.
+	NextPageCursor string
+}
+
+// OrderItem contains a single product in an order.
+type OrderItem struct {
+	ProductID string
+	Name      string
+	ImageURL  string
+	Currency  string
+	Price1000 int64
+	Quantity  int
+}
+
+// Order contains info about an order sent to a WhatsApp business.
+//
+// The basic fields are available directly in order messages (see whatsmeow.ParseOrderMessage),
+// while the items are only available by fetching the order details with Client.GetOrderDetails.
+type Order struct {
+	ID        string
+	Token     string
+	Title     string
+	Message   string
+	Status    string
+	SellerJID JID
+	ItemCount int
+
+	Currency       string
+	TotalPrice1000 int64
+
+	Items []OrderItem
+}
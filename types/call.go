@@ -19,3 +19,16 @@ type CallRemoteMeta struct {
 	RemotePlatform string // The platform of the caller's WhatsApp client
 	RemoteVersion  string // Version of the caller's WhatsApp client
 }
+
+// CallEndReason is the normalized reason why a call ended, as included in events.CallLog.
+type CallEndReason string
+
+const (
+	CallEndReasonUnknown           CallEndReason = ""
+	CallEndReasonEnded             CallEndReason = "ended"              // The call was answered and then hung up normally.
+	CallEndReasonTimeout           CallEndReason = "timeout"            // Nobody answered the call (i.e. a missed call).
+	CallEndReasonRejected          CallEndReason = "rejected"           // The call was rejected by the receiver.
+	CallEndReasonBusy              CallEndReason = "busy"               // The receiver was in another call.
+	CallEndReasonAcceptedElsewhere CallEndReason = "accepted_elsewhere" // The call was answered on another one of the user's devices.
+	CallEndReasonUnfinished        CallEndReason = "unfinished"         // The end of the call was never received, e.g. because the client was disconnected.
+)
@@ -0,0 +1,47 @@
+// Copyright (c) 2024 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package types
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+)
+
+// GetContextInfo finds the ContextInfo of the given message, regardless of which message type it is.
+// It returns nil if the message doesn't have a ContextInfo.
+func GetContextInfo(msg *waProto.Message) *waProto.ContextInfo {
+	return findContextInfo(msg, false)
+}
+
+// GetMutableContextInfo finds the ContextInfo of the given message like GetContextInfo,
+// but creates it if the message type supports one and doesn't have it yet.
+func GetMutableContextInfo(msg *waProto.Message) *waProto.ContextInfo {
+	return findContextInfo(msg, true)
+}
+
+func findContextInfo(msg *waProto.Message, mutable bool) (ctxInfo *waProto.ContextInfo) {
+	if msg == nil {
+		return nil
+	}
+	msg.ProtoReflect().Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if field.Kind() != protoreflect.MessageKind || field.IsList() || field.IsMap() {
+			return true
+		}
+		ctxField := field.Message().Fields().ByName("contextInfo")
+		if ctxField == nil {
+			return true
+		}
+		if mutable {
+			ctxInfo, _ = value.Message().Mutable(ctxField).Message().Interface().(*waProto.ContextInfo)
+		} else if value.Message().Has(ctxField) {
+			ctxInfo, _ = value.Message().Get(ctxField).Message().Interface().(*waProto.ContextInfo)
+		}
+		return ctxInfo == nil
+	})
+	return
+}
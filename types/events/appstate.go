@@ -31,6 +31,13 @@ type PushName struct {
 	NewPushName string             // The new push name that was included in the message.
 }
 
+// PushNamesSynced is emitted after push names from a PUSH_NAME history sync blob have been stored in the contact store.
+//
+// Only users who didn't already have a push name stored are included, as names seen in live messages are more recent.
+type PushNamesSynced struct {
+	Names map[types.JID]string // The users whose push names were stored, mapped to the new push name.
+}
+
 // BusinessName is emitted when a message is received with a different verified business name than the previous value cached for the same user.
 type BusinessName struct {
 	JID             types.JID
@@ -142,6 +149,15 @@ type UserStatusMute struct {
 	FromFullSync bool                          // Whether the action is emitted because of a fullSync
 }
 
+// StatusPrivacy is emitted when the user changes who their status updates are sent to by default.
+type StatusPrivacy struct {
+	Timestamp time.Time           // The time when the setting was changed
+	Privacy   types.StatusPrivacy // The new default status audience, including the allow or deny list if one is used
+
+	Action       *waProto.StatusPrivacyAction // The raw new setting
+	FromFullSync bool                         // Whether the action is emitted because of a fullSync
+}
+
 // LabelEdit is emitted when a label is edited from any device.
 type LabelEdit struct {
 	Timestamp time.Time // The time when the label was edited.
@@ -179,6 +195,27 @@ type AppState struct {
 	*waProto.SyncActionValue
 }
 
+// AppStateUnknownMutation is emitted when an app state mutation is received with an index type that whatsmeow doesn't
+// have a specific event for. This allows handling new settings that WhatsApp adds before the library supports them.
+type AppStateUnknownMutation struct {
+	Index     []string  // The full index of the mutation. The first item is the mutation type.
+	Timestamp time.Time // The time when the mutation happened.
+
+	Action       *waProto.SyncActionValue // The raw value of the mutation.
+	FromFullSync bool                     // Whether the action is emitted because of a fullSync
+}
+
+// AppStateSyncKeyReceived is emitted when the primary device shares an app state sync key with this device.
+//
+// The key is stored automatically, and any app state patches that couldn't be decrypted without it are refetched
+// after all keys in the share have been stored.
+type AppStateSyncKeyReceived struct {
+	KeyID     []byte
+	Timestamp time.Time
+	// True if the key was explicitly requested because app state patches couldn't be decrypted without it.
+	WasRequested bool
+}
+
 // AppStateSyncComplete is emitted when app state is resynced.
 type AppStateSyncComplete struct {
 	Name appstate.WAPatchName
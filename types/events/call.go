@@ -7,6 +7,8 @@
 package events
 
 import (
+	"time"
+
 	waBinary "go.mau.fi/whatsmeow/binary"
 	"go.mau.fi/whatsmeow/types"
 )
@@ -16,6 +18,8 @@ type CallOffer struct {
 	types.BasicCallMeta
 	types.CallRemoteMeta
 
+	IsVideo bool // True if the offer is for a video call
+
 	Data *waBinary.Node // The call offer data
 }
 
@@ -65,6 +69,37 @@ type CallTerminate struct {
 	Data   *waBinary.Node
 }
 
+// CallReject is emitted when a call is rejected, either by the receiver or by another one of the user's devices.
+type CallReject struct {
+	types.BasicCallMeta
+	Data *waBinary.Node
+}
+
+// CallLog is emitted once when a call ends, regardless of whether the client interacted with the call.
+// It summarizes everything that was received about the call, which is useful for keeping a complete call history
+// including missed and rejected calls.
+//
+// Calls that started before the client connected may be logged without an offer, in which case OfferedAt is zero.
+// Calls whose end is never received are logged with types.CallEndReasonUnfinished and a zero EndedAt when they're
+// pruned, which happens a couple of hours after the call started (the next time a new call is seen).
+type CallLog struct {
+	CallID      string
+	From        types.JID // The user (or group, for group calls) that the call events came from
+	CallCreator types.JID // The user who started the call
+
+	IsVideo      bool
+	IsGroup      bool
+	GroupJID     types.JID   // The group the call was started in, if it was a group call started from a group chat
+	Participants []types.JID // The other participants of group calls, if the server included them
+
+	OfferedAt  time.Time
+	AcceptedAt time.Time // Zero if the call was never answered
+	EndedAt    time.Time
+
+	EndReason types.CallEndReason
+	RawReason string // The reason from the terminate element, if any
+}
+
 // UnknownCallEvent is emitted when a call element with unknown content is received.
 type UnknownCallEvent struct {
 	Node *waBinary.Node
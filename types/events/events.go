@@ -8,8 +8,10 @@
 package events
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	waBinary "go.mau.fi/whatsmeow/binary"
@@ -24,15 +26,26 @@ import (
 //
 // The QR codes are available in the Codes slice. You should render the strings as QR codes one by
 // one, switching to the next one whenever enough time has passed. WhatsApp web seems to show the
-// first code for 60 seconds and all other codes for 20 seconds.
+// first code for 60 seconds and all other codes for 20 seconds. The util/qrcode package can be used
+// to render the strings as PNG images or terminal text.
 //
 // When the QR code has been scanned and pairing is complete, PairSuccess will be emitted. If you
 // run out of codes before scanning, the server will close the websocket, and you will have to
 // reconnect to get more codes.
+//
+// If Client.AutoRotateQR is enabled, Codes only contains the current code, and a new QR event is emitted
+// with the next code after Timeout has passed, so the app only needs to render the latest code.
 type QR struct {
 	Codes []string
+	// How long the code is valid for. This is only set when Client.AutoRotateQR is enabled.
+	Timeout time.Duration
 }
 
+// QRTimeout is emitted when Client.AutoRotateQR is enabled and the server closed the connection after all the QR codes
+// expired without being scanned. The client will reconnect to get new codes after this, which will be emitted as new
+// QR events. Disconnected is not emitted in this case.
+type QRTimeout struct{}
+
 // PairSuccess is emitted after the QR code has been scanned with the phone and the handshake has
 // been completed. Note that this is generally followed by a websocket reconnection, so you should
 // wait for the Connected before trying to send anything.
@@ -42,11 +55,30 @@ type PairSuccess struct {
 	Platform     string
 }
 
-// PairError is emitted when a pair-success event is received from the server, but finishing the pairing locally fails.
+// PairErrorReason is the category of a pairing failure in a PairError event.
+type PairErrorReason string
+
+const (
+	// PairErrorTimeout means all QR codes expired without being scanned, and the server closed the connection.
+	// Reconnecting will produce new QR codes, so apps can just offer to try again. This is not emitted if
+	// Client.AutoRotateQR is enabled, as new codes are fetched automatically (see QRTimeout).
+	PairErrorTimeout PairErrorReason = "timeout"
+	// PairErrorVerification means the pairing data sent by the phone failed cryptographic verification.
+	PairErrorVerification PairErrorReason = "verification"
+	// PairErrorRejected means the pairing was rejected, e.g. by Client.PrePairCallback.
+	PairErrorRejected PairErrorReason = "rejected"
+	// PairErrorInternal means the pairing data couldn't be parsed or stored.
+	PairErrorInternal PairErrorReason = "internal"
+)
+
+// PairError is emitted when pairing fails, either because a pair-success event was received from the server,
+// but finishing the pairing locally failed, or because the QR codes ran out (in which case Reason is PairErrorTimeout
+// and the device info fields are empty).
 type PairError struct {
 	ID           types.JID
 	BusinessName string
 	Platform     string
+	Reason       PairErrorReason
 	Error        error
 }
 
@@ -73,6 +105,32 @@ type KeepAliveTimeout struct {
 // Note that if the websocket disconnects before the pings start working, this event will not be emitted.
 type KeepAliveRestored struct{}
 
+// PhoneOffline is emitted when nothing has been received from the primary device (phone) for longer than
+// Client.PhoneOfflineThreshold. Messages are still received normally, as linked devices don't depend on the phone
+// being online, but the phone must come online occasionally or the linked device will eventually be logged out.
+//
+// This is a heuristic based on messages and receipts sent by the phone, not an actual online status from the server.
+// An idle phone that is online will also trigger this event.
+type PhoneOffline struct {
+	// The time when something was last received from the phone.
+	LastActivity time.Time
+}
+
+// PhoneReconnected is emitted when something is received from the phone after PhoneOffline was emitted.
+type PhoneReconnected struct {
+	LastActivity time.Time
+}
+
+// LinkedDevicesChanged is emitted when a device is linked to or removed from the user's account.
+// Notifications whose device list doesn't match the hash sent by the server are ignored.
+type LinkedDevicesChanged struct {
+	Devices []types.JID // The new list of all devices of the account, including the primary device and this device.
+	// The devices that were added and removed. These are only filled if the previous device list was known,
+	// e.g. after calling Client.GetLinkedDevices or sending a message to another one of the user's devices.
+	Added   []types.JID
+	Removed []types.JID
+}
+
 // PermanentDisconnect is a class of events emitted when the client will not auto-reconnect by default.
 type PermanentDisconnect interface {
 	PermanentDisconnectDescription() string
@@ -94,12 +152,18 @@ func (cf *ConnectFailure) PermanentDisconnectDescription() string {
 // This can happen while connected (stream:error messages) or right after connecting (connect failure messages).
 //
 // This will not be emitted when the logout is initiated by this client (using Client.LogOut()).
+//
+// The session data is deleted from the store before this is emitted, and auto-reconnect is disabled for
+// the current connection. The device must be paired again (e.g. using GetQRChannel) to reconnect.
 type LoggedOut struct {
 	// OnConnect is true if the event was triggered by a connect failure message.
 	// If it's false, the event was triggered by a stream:error message.
 	OnConnect bool
 	// If OnConnect is true, then this field contains the reason code.
 	Reason ConnectFailureReason
+	// DeviceRemoved is true if the user removed this device from the linked devices list on their phone
+	// while the client was connected.
+	DeviceRemoved bool
 }
 
 // StreamReplaced is emitted when the client is disconnected by another client connecting with the same keys.
@@ -224,8 +288,13 @@ type StreamError struct {
 	Raw  *waBinary.Node
 }
 
-// Disconnected is emitted when the websocket is closed by the server.
-type Disconnected struct{}
+// Disconnected is emitted when the websocket is closed by the server, or after Client.DisconnectGracefully.
+type Disconnected struct {
+	// Graceful is true if the disconnection was requested with Client.DisconnectGracefully.
+	// Otherwise, the connection was closed by the server or a network error, and the client will try to reconnect
+	// if auto-reconnect is enabled.
+	Graceful bool
+}
 
 // HistorySync is emitted when the phone has sent a blob of historical messages.
 type HistorySync struct {
@@ -264,15 +333,33 @@ type NewsletterMessageMeta struct {
 }
 
 // Message is emitted when receiving a new message.
+//
+// Incoming nodes are handled one at a time in the order the server sent them, which means that a message is always
+// dispatched before any reaction, edit, revocation or reply that arrived after it on the same connection.
+// Apps that index messages (e.g. by types.MessageInfo.Key()) can therefore rely on the original message being
+// indexed before the event referencing it, as long as the event handler processes events synchronously.
+//
+// There is no ordering guarantee between live messages and HistorySync events, as history syncs are downloaded and
+// dispatched in the background. Events may also reference messages that were never received at all, so the
+// original message not being found in an index must always be handled. Use Client.ParseMessageKey to convert keys
+// referenced inside messages into the canonical format.
 type Message struct {
 	Info    types.MessageInfo // Information about the message like the chat and sender IDs
 	Message *waProto.Message  // The actual message struct
 
 	IsEphemeral           bool // True if the message was unwrapped from an EphemeralMessage
-	IsViewOnce            bool // True if the message was unwrapped from a ViewOnceMessage or ViewOnceMessageV2
-	IsViewOnceV2          bool // True if the message was unwrapped from a ViewOnceMessage
+	IsViewOnce            bool // True if the message was unwrapped from a ViewOnceMessage, ViewOnceMessageV2 or ViewOnceMessageV2Extension
+	IsViewOnceV2          bool // True if the message was unwrapped from a ViewOnceMessageV2
+	IsViewOnceV2Extension bool // True if the message was unwrapped from a ViewOnceMessageV2Extension
 	IsDocumentWithCaption bool // True if the message was unwrapped from a DocumentWithCaptionMessage
 	IsEdit                bool // True if the message was unwrapped from an EditedMessage
+	IsGroupMention        bool // True if the message was unwrapped from a GroupMentionedMessage
+	IsBotInvoke           bool // True if the message was unwrapped from a BotInvokeMessage
+	IsLottieSticker       bool // True if the message was unwrapped from a LottieStickerMessage
+
+	IsMuted         bool // True if the chat is muted (based on the mute state synced via app state), so notifications should be suppressed. Only set if Client.CheckChatMuted is enabled.
+	IsForwarded     bool // True if the message was forwarded from another chat
+	ForwardingScore int  // How many times the message has been forwarded, see IsFrequentlyForwarded
 
 	// If this event was parsed from a WebMessageInfo (i.e. from a history sync or unavailable message request), the source data is here.
 	SourceWebMsg *waProto.WebMessageInfo
@@ -281,10 +368,23 @@ type Message struct {
 	// If the message was re-requested from the sender, this is the number of retries it took.
 	RetryCount int
 
+	// VerifiedSender is true if Client.CheckSenderVerification is enabled and the identity key of the sender device
+	// has been marked as verified using Client.SetIdentityVerified.
+	//
+	// Signal ciphertexts are authenticated, so a successful decryption proves that the message was encrypted by whoever
+	// holds the private identity key that the session belongs to. Sessions are looked up by the sender address, so the
+	// server can't inject messages or attribute them to another sender without that key. Group messages are signed
+	// with the sender key, which is itself received over the pairwise session with the sender device. However,
+	// identity keys are distributed by the server, so this only proves who the sender is if the key has been verified
+	// out of band. Keys that were trusted on first use, or that have changed since being verified, are not verified.
+	//
+	// Newsletter messages aren't end-to-end encrypted, so this is always false for them.
+	VerifiedSender bool
+
 	NewsletterMeta *NewsletterMessageMeta
 
 	// The raw message struct. This is the raw unmodified data, which means the actual message might
-	// be wrapped in DeviceSentMessage, EphemeralMessage or ViewOnceMessage.
+	// be wrapped in DeviceSentMessage, EphemeralMessage, ViewOnceMessage or other containers (see UnwrapMessage).
 	RawMessage *waProto.Message
 }
 
@@ -299,37 +399,116 @@ type FBMessage struct {
 	Application *waMsgApplication.MessageApplication // The second level of wrapping the message was in
 }
 
-// UnwrapRaw fills the Message, IsEphemeral and IsViewOnce fields based on the raw message in the RawMessage field.
-func (evt *Message) UnwrapRaw() *Message {
-	evt.Message = evt.RawMessage
-	if evt.Message.GetDeviceSentMessage().GetMessage() != nil {
-		evt.Info.DeviceSentMeta = &types.DeviceSentMeta{
-			DestinationJID: evt.Message.GetDeviceSentMessage().GetDestinationJid(),
-			Phash:          evt.Message.GetDeviceSentMessage().GetPhash(),
+// FrequentlyForwardedScore is the forwarding score at which WhatsApp clients label messages as "Forwarded many times".
+const FrequentlyForwardedScore = 5
+
+// IsFrequentlyForwarded returns true if the message has been forwarded so many times that
+// WhatsApp clients label it as "Forwarded many times".
+func (evt *Message) IsFrequentlyForwarded() bool {
+	return evt.ForwardingScore >= FrequentlyForwardedScore
+}
+
+// MessageWrapInfo contains information about the containers that UnwrapMessage removed from a message.
+type MessageWrapInfo struct {
+	// Metadata from the DeviceSentMessage container, which is used for messages sent from another one of the user's own devices.
+	DeviceSentMeta *types.DeviceSentMeta
+
+	IsEphemeral           bool // EphemeralMessage: the message was sent in a chat with disappearing messages enabled.
+	IsViewOnce            bool // Any of the view once containers.
+	IsViewOnceV2          bool // ViewOnceMessageV2, used for view once images and videos.
+	IsViewOnceV2Extension bool // ViewOnceMessageV2Extension, used for view once voice messages.
+	IsDocumentWithCaption bool // DocumentWithCaptionMessage.
+	IsEdit                bool // EditedMessage: the inner message is a ProtocolMessage containing the edit.
+	IsGroupMention        bool // GroupMentionedMessage.
+	IsBotInvoke           bool // BotInvokeMessage.
+	IsLottieSticker       bool // LottieStickerMessage: the inner message is a StickerMessage with a Lottie animation.
+
+	// If the message was an edit, this is the ID of the edited message, and the returned message is the new content.
+	EditTarget types.MessageID
+}
+
+// UnwrapMessage removes all known container types from the given message and returns the actual message
+// along with information about what it was wrapped in.
+//
+// The containers can be nested in any order, so this keeps unwrapping until none are left.
+// In addition to the containers handled by Message.UnwrapRaw, edits are unwrapped too: if the message
+// is a ProtocolMessage of type MESSAGE_EDIT, the new content of the edited message is returned
+// and MessageWrapInfo.EditTarget contains the ID of the message that was edited.
+func UnwrapMessage(msg *waProto.Message) (*waProto.Message, MessageWrapInfo) {
+	return unwrapMessage(msg, true)
+}
+
+func unwrapMessage(msg *waProto.Message, unwrapEdits bool) (*waProto.Message, MessageWrapInfo) {
+	var info MessageWrapInfo
+	for {
+		switch {
+		case msg.GetDeviceSentMessage().GetMessage() != nil:
+			info.DeviceSentMeta = &types.DeviceSentMeta{
+				DestinationJID: msg.GetDeviceSentMessage().GetDestinationJid(),
+				Phash:          msg.GetDeviceSentMessage().GetPhash(),
+			}
+			msg = msg.GetDeviceSentMessage().GetMessage()
+		case msg.GetEphemeralMessage().GetMessage() != nil:
+			msg = msg.GetEphemeralMessage().GetMessage()
+			info.IsEphemeral = true
+		case msg.GetViewOnceMessage().GetMessage() != nil:
+			msg = msg.GetViewOnceMessage().GetMessage()
+			info.IsViewOnce = true
+		case msg.GetViewOnceMessageV2().GetMessage() != nil:
+			msg = msg.GetViewOnceMessageV2().GetMessage()
+			info.IsViewOnce = true
+			info.IsViewOnceV2 = true
+		case msg.GetViewOnceMessageV2Extension().GetMessage() != nil:
+			msg = msg.GetViewOnceMessageV2Extension().GetMessage()
+			info.IsViewOnce = true
+			info.IsViewOnceV2Extension = true
+		case msg.GetDocumentWithCaptionMessage().GetMessage() != nil:
+			msg = msg.GetDocumentWithCaptionMessage().GetMessage()
+			info.IsDocumentWithCaption = true
+		case msg.GetEditedMessage().GetMessage() != nil:
+			msg = msg.GetEditedMessage().GetMessage()
+			info.IsEdit = true
+		case msg.GetGroupMentionedMessage().GetMessage() != nil:
+			msg = msg.GetGroupMentionedMessage().GetMessage()
+			info.IsGroupMention = true
+		case msg.GetBotInvokeMessage().GetMessage() != nil:
+			msg = msg.GetBotInvokeMessage().GetMessage()
+			info.IsBotInvoke = true
+		case msg.GetLottieStickerMessage().GetMessage() != nil:
+			msg = msg.GetLottieStickerMessage().GetMessage()
+			info.IsLottieSticker = true
+		case unwrapEdits && msg.GetProtocolMessage().GetType() == waProto.ProtocolMessage_MESSAGE_EDIT &&
+			msg.GetProtocolMessage().GetEditedMessage() != nil:
+			info.EditTarget = types.MessageID(msg.GetProtocolMessage().GetKey().GetId())
+			msg = msg.GetProtocolMessage().GetEditedMessage()
+		default:
+			return msg, info
 		}
-		evt.Message = evt.Message.GetDeviceSentMessage().GetMessage()
-	}
-	if evt.Message.GetEphemeralMessage().GetMessage() != nil {
-		evt.Message = evt.Message.GetEphemeralMessage().GetMessage()
-		evt.IsEphemeral = true
-	}
-	if evt.Message.GetViewOnceMessage().GetMessage() != nil {
-		evt.Message = evt.Message.GetViewOnceMessage().GetMessage()
-		evt.IsViewOnce = true
 	}
-	if evt.Message.GetViewOnceMessageV2().GetMessage() != nil {
-		evt.Message = evt.Message.GetViewOnceMessageV2().GetMessage()
-		evt.IsViewOnce = true
-		evt.IsViewOnceV2 = true
-	}
-	if evt.Message.GetDocumentWithCaptionMessage().GetMessage() != nil {
-		evt.Message = evt.Message.GetDocumentWithCaptionMessage().GetMessage()
-		evt.IsDocumentWithCaption = true
-	}
-	if evt.Message.GetEditedMessage().GetMessage() != nil {
-		evt.Message = evt.Message.GetEditedMessage().GetMessage()
-		evt.IsEdit = true
+}
+
+// UnwrapRaw fills the Message, IsEphemeral and IsViewOnce fields based on the raw message in the RawMessage field.
+//
+// The IsForwarded and ForwardingScore fields are also filled based on the ContextInfo of the unwrapped message.
+// Unlike UnwrapMessage, this doesn't unwrap the new content of edits, so edits are still ProtocolMessages in the Message field.
+func (evt *Message) UnwrapRaw() *Message {
+	var wrapInfo MessageWrapInfo
+	evt.Message, wrapInfo = unwrapMessage(evt.RawMessage, false)
+	if wrapInfo.DeviceSentMeta != nil {
+		evt.Info.DeviceSentMeta = wrapInfo.DeviceSentMeta
 	}
+	evt.IsEphemeral = wrapInfo.IsEphemeral
+	evt.IsViewOnce = wrapInfo.IsViewOnce
+	evt.IsViewOnceV2 = wrapInfo.IsViewOnceV2
+	evt.IsViewOnceV2Extension = wrapInfo.IsViewOnceV2Extension
+	evt.IsDocumentWithCaption = wrapInfo.IsDocumentWithCaption
+	evt.IsEdit = wrapInfo.IsEdit
+	evt.IsGroupMention = wrapInfo.IsGroupMention
+	evt.IsBotInvoke = wrapInfo.IsBotInvoke
+	evt.IsLottieSticker = wrapInfo.IsLottieSticker
+	ctxInfo := types.GetContextInfo(evt.Message)
+	evt.IsForwarded = ctxInfo.GetIsForwarded()
+	evt.ForwardingScore = int(ctxInfo.GetForwardingScore())
 	return evt
 }
 
@@ -381,9 +560,26 @@ type Presence struct {
 	LastSeen time.Time
 }
 
+// PresenceSubscribeResult is emitted when the outcome of a presence subscription made with Client.SubscribePresence
+// becomes known, i.e. when the first presence update or an error is received for the user. This can be used to
+// distinguish users who are just offline from users whose presence we're not allowed to see.
+//
+// Note that the server may also silently ignore subscriptions, in which case this event is never emitted.
+type PresenceSubscribeResult struct {
+	JID     types.JID
+	Allowed bool
+	Reason  types.PresenceSubscribeReason
+	// The error code and text returned by the server, if the subscription was denied.
+	ErrorCode int
+	ErrorText string
+}
+
 // JoinedGroup is emitted when you join or are added to a group.
+//
+// If you're added to a group with a normal participant add notification, the GroupInfo event
+// is emitted first, followed by this event once the full group info has been fetched.
 type JoinedGroup struct {
-	Reason    string          // If the event was triggered by you using an invite link, this will be "invite".
+	Reason    string          // If the event was triggered by you using an invite link, this will be "invite". For add notifications, this is the same as GroupInfo.JoinReason.
 	Type      string          // "new" if it's a newly created group.
 	CreateKey types.MessageID // If you created the group, this is the same message ID you passed to CreateGroup.
 	types.GroupInfo
@@ -402,10 +598,12 @@ type GroupInfo struct {
 	Announce  *types.GroupAnnounce  // Group announce status change (can only admins send messages?)
 	Ephemeral *types.GroupEphemeral // Disappearing messages change
 
+	MembershipApprovalMode *types.GroupMembershipApprovalMode // Join approval mode change (must admins approve new members?)
+
 	Delete *types.GroupDelete
 
-	Link   *types.GroupLinkChange
-	Unlink *types.GroupLinkChange
+	Link   *types.GroupLinkChange // A group was linked to a community (community membership change)
+	Unlink *types.GroupLinkChange // A group was unlinked from a community
 
 	NewInviteLink *string // Group invite link change
 
@@ -423,6 +621,53 @@ type GroupInfo struct {
 	UnknownChanges []*waBinary.Node
 }
 
+// AddressingModeChanged is emitted when whatsmeow notices that a group has switched between phone number and
+// LID addressing (see types.AddressingMode). Messages sent to the group after this use the new addressing mode.
+type AddressingModeChanged struct {
+	Chat     types.JID
+	Previous types.AddressingMode
+	Mode     types.AddressingMode
+}
+
+// JoinApprovalRequest is emitted when users request to join a group that requires admin approval
+// (see types.GroupMembershipApprovalMode), or when they cancel their request.
+//
+// Requests can be approved or rejected using Client.UpdateGroupRequestParticipants.
+type JoinApprovalRequest struct {
+	JID          types.JID   // The group that the users requested to join
+	Participants []types.JID // The users who requested to join
+	Timestamp    time.Time
+
+	RequestMethod string // How the users requested to join, e.g. "invite_link"
+	Revoked       bool   // True if the users cancelled their requests
+}
+
+// PreKeyCountFromServer is emitted when the server tells how many one-time prekeys it has left for this device.
+// If the count is below the minimum, whatsmeow automatically uploads more prekeys (in which case WillRefill is true).
+type PreKeyCountFromServer struct {
+	Count      int
+	Timestamp  time.Time
+	WillRefill bool
+}
+
+// AccountSync is emitted when the server notifies about changes to account-level data (e.g. privacy settings,
+// own devices, profile picture or blocklist). The changes that whatsmeow understands are also emitted as their
+// own events (like PrivacySettings or Blocklist) before this one.
+type AccountSync struct {
+	Timestamp time.Time
+	// The tags of all the items in the notification.
+	Items []string
+	// Items which whatsmeow doesn't handle.
+	UnknownItems []*waBinary.Node
+}
+
+// UnknownNotification is emitted for notification nodes whose type isn't handled by whatsmeow.
+// The notification has already been acknowledged.
+type UnknownNotification struct {
+	Type string
+	Node *waBinary.Node
+}
+
 // Picture is emitted when a user's profile picture or group's photo is changed.
 //
 // You can use Client.GetProfilePictureInfo to get the actual image URL after this event.
@@ -442,6 +687,66 @@ type IdentityChange struct {
 	// Implicit will be set to true if the event was triggered by an untrusted identity error,
 	// rather than an identity change notification from the server.
 	Implicit bool
+	// AutoAccepted is true if the old identity and sessions were removed automatically, which means the new
+	// identity will be trusted. For implicit changes, this depends on Client.AutoTrustIdentity, and the new key is
+	// stored in place of the old one (it's false if storing the new key failed). Explicit changes from the server
+	// always delete the old identity, but if the store rejects unknown identities, an UnknownIdentity event
+	// will follow when the new key is first seen.
+	AutoAccepted bool
+
+	// The previously stored identity key of the device, if it was known.
+	OldIdentityKey []byte
+	// The new identity key of the device. This is only available for implicit changes.
+	NewIdentityKey []byte
+}
+
+// OldFingerprint returns the fingerprint of the old identity key (see IdentityKeyFingerprint),
+// or an empty string if the old key is not known.
+func (ic *IdentityChange) OldFingerprint() string {
+	return IdentityKeyFingerprint(ic.OldIdentityKey)
+}
+
+// NewFingerprint returns the fingerprint of the new identity key (see IdentityKeyFingerprint),
+// or an empty string if the new key is not known.
+func (ic *IdentityChange) NewFingerprint() string {
+	return IdentityKeyFingerprint(ic.NewIdentityKey)
+}
+
+// IdentityKeyFingerprint formats the given identity key as a human-readable fingerprint,
+// i.e. uppercase hex split into groups of four characters.
+func IdentityKeyFingerprint(key []byte) string {
+	if len(key) == 0 {
+		return ""
+	}
+	hexKey := strings.ToUpper(hex.EncodeToString(key))
+	parts := make([]string, 0, len(hexKey)/4+1)
+	for i := 0; i < len(hexKey); i += 4 {
+		parts = append(parts, hexKey[i:min(i+4, len(hexKey))])
+	}
+	return strings.Join(parts, " ")
+}
+
+// UnknownIdentity is emitted when encrypting or decrypting fails because the identity key of the other device
+// hasn't been trusted yet. This only happens if the store is configured to reject unknown identities
+// (e.g. sqlstore.Container.RejectUnknownIdentities). Use Client.TrustIdentity to trust the key after verifying it.
+type UnknownIdentity struct {
+	JID         types.JID
+	IdentityKey [32]byte
+	Timestamp   time.Time
+}
+
+// DisappearingTimerChanged is emitted when the disappearing message timer of a chat
+// or the account-wide default timer for new chats changes.
+type DisappearingTimerChanged struct {
+	// IsDefault is true if the account-wide default timer changed. Chat and Sender are empty in that case.
+	IsDefault bool
+	// The chat whose timer changed.
+	Chat types.JID
+	// The user who changed the timer, if known.
+	Sender types.JID
+	// The new timer. Zero means disappearing messages were disabled.
+	Timer     time.Duration
+	Timestamp time.Time
 }
 
 // PrivacySettings is emitted when the user changes their privacy settings.
@@ -457,7 +762,11 @@ type PrivacySettings struct {
 }
 
 // OfflineSyncPreview is emitted right after connecting if the server is going to send events that the client missed during downtime.
+//
+// Messages received as a part of the offline sync have MessageInfo.IsOffline set,
+// which can be used to e.g. suppress notifications for old messages.
 type OfflineSyncPreview struct {
+	// The total number of queued events (messages, notifications, receipts and app data changes).
 	Total int
 
 	AppDataChanges int
@@ -467,7 +776,26 @@ type OfflineSyncPreview struct {
 }
 
 // OfflineSyncCompleted is emitted after the server has finished sending missed events.
+// Events received after this are from the live stream.
 type OfflineSyncCompleted struct {
+	// The number of events that were sent during the offline sync.
+	Count int
+}
+
+// EventsResumed is emitted after Client.ResumeEvents has dispatched all events that were buffered while paused.
+type EventsResumed struct {
+	// The number of buffered events that were dispatched before this event.
+	Buffered int
+}
+
+// OfflineMessagesDropped is emitted right before OfflineSyncCompleted if the server sent fewer messages during
+// the offline sync than it announced in the OfflineSyncPreview. This usually means that the server's offline
+// queue overflowed (e.g. because the client was offline for a long time), so there's a gap in the timeline.
+//
+// The dropped messages can't be re-fetched from the server, but they can be backfilled from the primary device
+// by sending an on-demand history sync request (see Client.BuildHistorySyncRequest) for the affected chats.
+type OfflineMessagesDropped struct {
+	// The number of announced messages that weren't received.
 	Count int
 }
 
@@ -489,6 +817,14 @@ type MediaRetry struct {
 	ChatID    types.JID       // The chat ID where the message was sent.
 	SenderID  types.JID       // The user who sent the message. Only present in groups.
 	FromMe    bool            // Whether the message was sent by the current user or someone else.
+
+	// If the retry was requested with Client.SendMediaRetryReceipt using the same client, the response is decrypted
+	// automatically and the result is here. Otherwise, this is nil and the response must be decrypted manually
+	// with whatsmeow.DecryptMediaRetryNotification.
+	Result *waProto.MediaRetryNotification
+	// The new direct path of the media, if the response was decrypted automatically and the re-upload succeeded.
+	// This can be set as the DirectPath of the original message to download the media again.
+	DirectPath string
 }
 
 type BlocklistAction string
@@ -534,8 +870,31 @@ type NewsletterMuteChange struct {
 	Mute types.NewsletterMuteState `json:"mute"`
 }
 
+// NewsletterJoinRequest is emitted when a user asks to join a restricted WhatsApp channel that you're an admin of.
+type NewsletterJoinRequest struct {
+	ID        types.JID // The channel that the user wants to join.
+	User      types.JID // The user who requested to join.
+	PushName  string    // The push name of the user, if the server included it.
+	Timestamp time.Time
+}
+
+// NewsletterAdminInvite is emitted when another admin invites you to become an admin of a WhatsApp channel,
+// or when such an invite is revoked.
+type NewsletterAdminInvite struct {
+	ID        types.JID // The channel that the invite is for.
+	Inviter   types.JID // The admin who sent or revoked the invite.
+	PushName  string    // The push name of the inviter, if the server included it.
+	Revoked   bool      // True if the invite was revoked rather than sent.
+	ExpiresAt time.Time // The time when the invite expires, if known.
+}
+
+// NewsletterLiveUpdate is emitted when the view counts or reactions of messages in a WhatsApp channel change.
+//
+// Live updates are only sent after subscribing with Client.NewsletterSubscribeLiveUpdates,
+// and the subscription must be renewed before the returned duration runs out.
 type NewsletterLiveUpdate struct {
-	JID      types.JID
-	Time     time.Time
+	JID  types.JID // The channel whose messages were updated.
+	Time time.Time // The time of the update.
+	// The updated messages. Only the server ID and the counts are present, the message content is not included.
 	Messages []*types.NewsletterMessage
 }
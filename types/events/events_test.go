@@ -0,0 +1,94 @@
+// Copyright (c) 2024 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package events
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+)
+
+func TestUnwrapMessage(t *testing.T) {
+	text := &waProto.Message{Conversation: proto.String("hello")}
+	sticker := &waProto.Message{StickerMessage: &waProto.StickerMessage{IsLottie: proto.Bool(true)}}
+	edit := &waProto.Message{ProtocolMessage: &waProto.ProtocolMessage{
+		Type:          waProto.ProtocolMessage_MESSAGE_EDIT.Enum(),
+		Key:           &waProto.MessageKey{Id: proto.String("ORIGINAL")},
+		EditedMessage: text,
+	}}
+	tests := []struct {
+		name     string
+		msg      *waProto.Message
+		expected *waProto.Message
+		info     MessageWrapInfo
+	}{
+		{"Plain", text, text, MessageWrapInfo{}},
+		{"Ephemeral", &waProto.Message{
+			EphemeralMessage: &waProto.FutureProofMessage{Message: text},
+		}, text, MessageWrapInfo{IsEphemeral: true}},
+		{"DeviceSent", &waProto.Message{
+			DeviceSentMessage: &waProto.DeviceSentMessage{DestinationJid: proto.String("123@s.whatsapp.net"), Message: text},
+		}, text, MessageWrapInfo{DeviceSentMeta: &types.DeviceSentMeta{DestinationJID: "123@s.whatsapp.net"}}},
+		{"ViewOnceV2InEphemeral", &waProto.Message{
+			EphemeralMessage: &waProto.FutureProofMessage{Message: &waProto.Message{
+				ViewOnceMessageV2: &waProto.FutureProofMessage{Message: text},
+			}},
+		}, text, MessageWrapInfo{IsEphemeral: true, IsViewOnce: true, IsViewOnceV2: true}},
+		{"ViewOnceV2Extension", &waProto.Message{
+			ViewOnceMessageV2Extension: &waProto.FutureProofMessage{Message: text},
+		}, text, MessageWrapInfo{IsViewOnce: true, IsViewOnceV2Extension: true}},
+		{"DocumentWithCaption", &waProto.Message{
+			DocumentWithCaptionMessage: &waProto.FutureProofMessage{Message: text},
+		}, text, MessageWrapInfo{IsDocumentWithCaption: true}},
+		{"GroupMention", &waProto.Message{
+			GroupMentionedMessage: &waProto.FutureProofMessage{Message: text},
+		}, text, MessageWrapInfo{IsGroupMention: true}},
+		{"BotInvoke", &waProto.Message{
+			BotInvokeMessage: &waProto.FutureProofMessage{Message: text},
+		}, text, MessageWrapInfo{IsBotInvoke: true}},
+		{"LottieSticker", &waProto.Message{
+			LottieStickerMessage: &waProto.FutureProofMessage{Message: sticker},
+		}, sticker, MessageWrapInfo{IsLottieSticker: true}},
+		{"Edit", edit, text, MessageWrapInfo{EditTarget: "ORIGINAL"}},
+		{"EditedMessageContainer", &waProto.Message{
+			EditedMessage: &waProto.FutureProofMessage{Message: edit},
+		}, text, MessageWrapInfo{IsEdit: true, EditTarget: "ORIGINAL"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			msg, info := UnwrapMessage(test.msg)
+			if !proto.Equal(msg, test.expected) {
+				t.Errorf("Unexpected unwrapped message: %v", msg)
+			}
+			if (info.DeviceSentMeta == nil) != (test.info.DeviceSentMeta == nil) ||
+				(info.DeviceSentMeta != nil && *info.DeviceSentMeta != *test.info.DeviceSentMeta) {
+				t.Errorf("Unexpected device sent metadata: %+v", info.DeviceSentMeta)
+			}
+			info.DeviceSentMeta, test.info.DeviceSentMeta = nil, nil
+			if info != test.info {
+				t.Errorf("Unexpected wrap info: %+v, expected %+v", info, test.info)
+			}
+		})
+	}
+}
+
+func TestUnwrapRawKeepsEdits(t *testing.T) {
+	edit := &waProto.Message{ProtocolMessage: &waProto.ProtocolMessage{
+		Type:          waProto.ProtocolMessage_MESSAGE_EDIT.Enum(),
+		Key:           &waProto.MessageKey{Id: proto.String("ORIGINAL")},
+		EditedMessage: &waProto.Message{Conversation: proto.String("hello")},
+	}}
+	evt := (&Message{RawMessage: &waProto.Message{
+		EditedMessage: &waProto.FutureProofMessage{Message: edit},
+	}}).UnwrapRaw()
+	if !evt.IsEdit || !proto.Equal(evt.Message, edit) {
+		t.Errorf("Expected UnwrapRaw to return the edit protocol message, got %v (IsEdit: %t)", evt.Message, evt.IsEdit)
+	}
+}
@@ -12,6 +12,14 @@ import (
 
 type GroupMemberAddMode string
 
+// AddressingMode specifies whether participants of a group are identified by their phone numbers or by hidden user IDs (LIDs).
+type AddressingMode string
+
+const (
+	AddressingModePN  AddressingMode = "pn"
+	AddressingModeLID AddressingMode = "lid"
+)
+
 const (
 	GroupMemberAddModeAdmin GroupMemberAddMode = "admin_add"
 )
@@ -27,6 +35,7 @@ type GroupInfo struct {
 	GroupAnnounce
 	GroupEphemeral
 	GroupIncognito
+	GroupMembershipApprovalMode
 
 	GroupParent
 	GroupLinkedParent
@@ -38,17 +47,26 @@ type GroupInfo struct {
 	Participants         []GroupParticipant
 
 	MemberAddMode GroupMemberAddMode
+
+	// The addressing mode of the group. In LID-addressed groups, participant JIDs are on the HiddenUserServer
+	// and messages must be encrypted using the LID of the sender.
+	AddressingMode AddressingMode
 }
 
+// GroupParent contains info about communities, i.e. groups that have other groups linked to them.
+// The parent group itself isn't a normal chat, messages are sent to the linked subgroups instead.
 type GroupParent struct {
 	IsParent                      bool
 	DefaultMembershipApprovalMode string // request_required
 }
 
+// GroupLinkedParent contains the community that the group is linked to, if any.
 type GroupLinkedParent struct {
 	LinkedParentJID JID
 }
 
+// GroupIsDefaultSub specifies whether the group is the announcement group of its community.
+// Announcement groups contain all community members, and only admins can send messages in them.
 type GroupIsDefaultSub struct {
 	IsDefaultSubGroup bool
 }
@@ -84,6 +102,11 @@ type GroupIncognito struct {
 	IsIncognito bool
 }
 
+// GroupMembershipApprovalMode specifies whether admins must approve new members before they can join the group.
+type GroupMembershipApprovalMode struct {
+	IsJoinApprovalRequired bool
+}
+
 // GroupParticipant contains info about a participant of a WhatsApp group chat.
 type GroupParticipant struct {
 	JID          JID
@@ -137,6 +160,11 @@ type GroupLinkTarget struct {
 	GroupIsDefaultSub
 }
 
+// GroupLinkChange contains info about a group being linked to or unlinked from a community
+// (see events.GroupInfo.Link and Unlink).
+//
+// In notifications sent to the community, Type is GroupLinkChangeTypeSub and Group is the linked subgroup.
+// In notifications sent to the subgroup, Type is GroupLinkChangeTypeParent and Group is the community.
 type GroupLinkChange struct {
 	Type         GroupLinkChangeType
 	UnlinkReason GroupUnlinkReason
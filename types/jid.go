@@ -102,6 +102,38 @@ func (jid JID) SignalAddress() *signalProtocol.SignalAddress {
 	//return signalProtocol.NewSignalAddress(user, uint32(jid.Device), suffix)
 }
 
+// SignalAddressString returns the Signal protocol address of the device as a string, e.g. "1234567890:0" for
+// phone number JIDs or "1234567890_1:5" for LIDs. This is the format used as the key for sessions and identities
+// in the store, so it should always be used instead of building addresses manually. See ParseSignalAddress for the inverse.
+func (jid JID) SignalAddressString() string {
+	return jid.SignalAddress().String()
+}
+
+// ParseSignalAddress parses a Signal protocol address string (as returned by JID.SignalAddressString)
+// back into a device JID.
+func ParseSignalAddress(address string) (JID, error) {
+	sep := strings.LastIndexByte(address, ':')
+	if sep <= 0 {
+		return EmptyJID, fmt.Errorf("signal address %q doesn't contain a device ID", address)
+	}
+	device, err := strconv.ParseUint(address[sep+1:], 10, 16)
+	if err != nil {
+		return EmptyJID, fmt.Errorf("failed to parse device ID in signal address: %w", err)
+	}
+	user := address[:sep]
+	var agent uint64
+	if agentSep := strings.LastIndexByte(user, '_'); agentSep > 0 {
+		agent, err = strconv.ParseUint(user[agentSep+1:], 10, 8)
+		if err != nil {
+			return EmptyJID, fmt.Errorf("failed to parse agent in signal address: %w", err)
+		}
+		user = user[:agentSep]
+	}
+	jid := NewADJID(user, uint8(agent), 0)
+	jid.Device = uint16(device)
+	return jid, nil
+}
+
 // IsBroadcastList returns true if the JID is a broadcast list, but not the status broadcast.
 func (jid JID) IsBroadcastList() bool {
 	return jid.Server == BroadcastServer && jid.User != StatusBroadcastJID.User
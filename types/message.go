@@ -21,6 +21,10 @@ type MessageSource struct {
 	// When sending a read receipt to a broadcast list message, the Chat is the broadcast list
 	// and Sender is you, so this field contains the recipient of the read receipt.
 	BroadcastListOwner JID
+
+	// AddressingMode is the way the Sender is identified in group messages: either by phone number
+	// or by hidden user ID (LID), in which case Sender is a @lid JID. This is empty for non-group messages.
+	AddressingMode AddressingMode
 }
 
 // IsIncomingBroadcast returns true if the message was sent to a broadcast list instead of directly to the user.
@@ -54,16 +58,53 @@ type MessageInfo struct {
 	ServerID  MessageServerID
 	Type      string
 	PushName  string
-	Timestamp time.Time
+	Timestamp time.Time // The time when the server received the message. This should be used for ordering messages.
 	Category  string
 	Multicast bool
 	MediaType string
 	Edit      EditAttribute
+	// IsOffline is true if the message was queued on the server while the client was offline and delivered as a
+	// part of the offline sync after connecting (see events.OfflineSyncPreview and events.OfflineSyncCompleted).
+	IsOffline bool
+
+	// The time when the message was created according to the sender's device clock. This is only present for
+	// message types that include it in the protobuf (e.g. reactions, edits and poll votes) and may be skewed.
+	ClientTimestamp time.Time
+
+	// The ID of the album message that this message is a part of, if it's an item in an album (see Client.SendAlbum).
+	AlbumID MessageID
 
 	VerifiedName   *VerifiedName
 	DeviceSentMeta *DeviceSentMeta // Metadata for direct messages sent from another one of the user's own devices.
 }
 
+// MessageKey uniquely identifies a message. It's meant to be used as a canonical key when indexing messages,
+// so that messages referenced by replies, reactions, edits and revocations can be looked up consistently.
+//
+// Sender is always a non-AD JID (i.e. without the device part), and IsFromMe is relative to the current user,
+// regardless of who sent the message that referenced this key.
+type MessageKey struct {
+	Chat     JID
+	Sender   JID
+	ID       MessageID
+	IsFromMe bool
+}
+
+// Key returns the canonical MessageKey of the message.
+func (info *MessageInfo) Key() MessageKey {
+	return MessageKey{
+		Chat:     info.Chat,
+		Sender:   info.Sender.ToNonAD(),
+		ID:       info.ID,
+		IsFromMe: info.IsFromMe,
+	}
+}
+
+// String returns a log-friendly representation of the message key.
+func (key MessageKey) String() string {
+	return fmt.Sprintf("%s/%s/%s", key.Chat, key.Sender, key.ID)
+}
+
 // SourceString returns a log-friendly representation of who sent the message and where.
 func (ms *MessageSource) SourceString() string {
 	if ms.Sender != ms.Chat {
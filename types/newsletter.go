@@ -146,10 +146,13 @@ type NewsletterText struct {
 	UpdateTime jsontime.UnixMicroString `json:"update_time"`
 }
 
+// NewsletterMessage contains a message in a WhatsApp channel along with its view count and reaction tallies.
 type NewsletterMessage struct {
 	MessageServerID MessageServerID
-	ViewsCount      int
-	ReactionCounts  map[string]int
+	// The number of views. Zero if not included in the response or live update.
+	ViewsCount int
+	// The number of reactions per emoji. Nil if not included in the response or live update.
+	ReactionCounts map[string]int
 
 	// This is only present when fetching messages, not in live updates
 	Message *waProto.Message
@@ -17,6 +17,19 @@ const (
 	PresenceUnavailable Presence = "unavailable"
 )
 
+// PresenceSubscribeReason explains the result of a presence subscription in events.PresenceSubscribeResult.
+type PresenceSubscribeReason string
+
+const (
+	// PresenceSubscribeReasonNone means the subscription was accepted and presence is fully visible.
+	PresenceSubscribeReasonNone PresenceSubscribeReason = ""
+	// PresenceSubscribeReasonLastSeenHidden means the subscription was accepted, but the user hides their last seen time.
+	PresenceSubscribeReasonLastSeenHidden PresenceSubscribeReason = "last_seen_hidden"
+	// PresenceSubscribeReasonDenied means the server refused the subscription, e.g. because the user has blocked us
+	// or their privacy settings don't allow us to see their presence.
+	PresenceSubscribeReasonDenied PresenceSubscribeReason = "denied"
+)
+
 type ChatPresence string
 
 const (
@@ -49,9 +49,27 @@ type ContactInfo struct {
 type LocalChatSettings struct {
 	Found bool
 
+	// The time when the chat will be unmuted. This is MutedForever() if the chat is muted indefinitely,
+	// and zero or in the past if the chat isn't muted.
 	MutedUntil time.Time
 	Pinned     bool
 	Archived   bool
+	// The IDs of the business labels applied to the chat.
+	Labels []string
+}
+
+// MutedForever returns the LocalChatSettings.MutedUntil value of chats that are muted indefinitely.
+func MutedForever() time.Time {
+	return time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC)
+}
+
+// Label contains the info of a WhatsApp Business label, which can be applied to chats and messages.
+type Label struct {
+	ID    string
+	Name  string
+	Color int32
+	// The ID of the predefined label (e.g. "New customer") this label is based on, or zero for custom labels.
+	PredefinedID int32
 }
 
 // IsOnWhatsAppResponse contains information received in response to checking if a phone number is on WhatsApp.
@@ -82,6 +100,28 @@ type ContactQRLinkTarget struct {
 	PushName string // The notify / push name of the user.
 }
 
+// OwnDeviceInfo contains information about the device that the client is logged in as (see Client.GetOwnDeviceInfo).
+type OwnDeviceInfo struct {
+	JID            JID    // The JID of this device, including the device part. Empty if the client isn't paired.
+	PushName       string // The display name of the user.
+	BusinessName   string // The verified business name, if the account is a business account.
+	Platform       string // The platform of the primary device, as reported during pairing.
+	RegistrationID uint32 // The Signal registration ID of this device.
+}
+
+// LinkedDevice contains information about a device linked to the user's account (see Client.GetLinkedDevices).
+type LinkedDevice struct {
+	JID          JID
+	IsPrimary    bool   // True for the primary device (phone), which always has device ID 0.
+	IsThisDevice bool   // True for the device that the client is logged in as.
+	KeyIndex     uint32 // The index of the device in the account's signed device list. Zero for the primary device.
+
+	// The device list from the server doesn't include platforms or activity times, so these are only set for the
+	// primary device, using the platform reported during pairing and Client.LastPrimaryDeviceActivity.
+	Platform   string
+	LastActive time.Time
+}
+
 // PrivacySetting is an individual setting value in the user's privacy settings.
 type PrivacySetting string
 
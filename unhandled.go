@@ -0,0 +1,49 @@
+// Copyright (c) 2022 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"runtime/debug"
+
+	waBinary "go.mau.fi/whatsmeow/binary"
+)
+
+// UnhandledNodeHandler is a function that receives incoming nodes that whatsmeow didn't map to any event.
+//
+// The stanza type is the tag of the top-level stanza (e.g. "notification", "call" or "ib"). The node is either the
+// whole stanza, or only the unknown element inside it if the rest of the stanza was handled.
+type UnhandledNodeHandler func(stanzaType string, node *waBinary.Node)
+
+// AddUnhandledNodeHandler registers a function that will be called with incoming nodes that whatsmeow didn't
+// understand, i.e. ones that would otherwise only be logged at debug level. This is mostly useful for discovering
+// new features of the WhatsApp protocol.
+//
+// Nothing is done with unhandled nodes unless at least one handler is registered, so there's no overhead if this
+// isn't used. The same rules as event handlers apply: handlers are called synchronously and must not block.
+func (cli *Client) AddUnhandledNodeHandler(handler UnhandledNodeHandler) {
+	cli.unhandledNodeHandlersLock.Lock()
+	cli.unhandledNodeHandlers = append(cli.unhandledNodeHandlers, handler)
+	cli.hasUnhandledNodeHandlers.Store(true)
+	cli.unhandledNodeHandlersLock.Unlock()
+}
+
+func (cli *Client) dispatchUnhandledNode(stanzaType string, node *waBinary.Node) {
+	if !cli.hasUnhandledNodeHandlers.Load() {
+		return
+	}
+	cli.unhandledNodeHandlersLock.RLock()
+	defer func() {
+		cli.unhandledNodeHandlersLock.RUnlock()
+		err := recover()
+		if err != nil {
+			cli.Log.Errorf("Unhandled node handler panicked while handling a %s node: %v\n%s", stanzaType, err, debug.Stack())
+		}
+	}()
+	for _, handler := range cli.unhandledNodeHandlers {
+		handler(stanzaType, node)
+	}
+}
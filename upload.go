@@ -34,6 +34,25 @@ type UploadResponse struct {
 	FileEncSHA256 []byte `json:"-"`
 	FileSHA256    []byte `json:"-"`
 	FileLength    uint64 `json:"-"`
+	// StreamingSidecar allows recipients to seek in videos and audio before downloading the whole file.
+	// It's only generated for MediaVideo and MediaAudio uploads.
+	StreamingSidecar []byte `json:"-"`
+}
+
+const streamingSidecarChunkSize = 64 * 1024
+
+// generateStreamingSidecar generates the streaming sidecar for an encrypted media file, which contains
+// a truncated HMAC for each 64 KiB chunk. Each chunk includes the previous 16 bytes (or the IV for the
+// first chunk), as they're needed to decrypt the chunk independently.
+func generateStreamingSidecar(iv, encFile, macKey []byte) []byte {
+	data := append(append(make([]byte, 0, len(iv)+len(encFile)), iv...), encFile...)
+	sidecar := make([]byte, 0, (len(encFile)/streamingSidecarChunkSize+1)*10)
+	for i := 0; i < len(data)-len(iv); i += streamingSidecarChunkSize {
+		h := hmac.New(sha256.New, macKey)
+		h.Write(data[i:min(i+streamingSidecarChunkSize+len(iv), len(data))])
+		sidecar = append(sidecar, h.Sum(nil)[:10]...)
+	}
+	return sidecar
 }
 
 // Upload uploads the given attachment to WhatsApp servers.
@@ -63,6 +82,8 @@ type UploadResponse struct {
 //	// handle error again
 //
 // The same applies to the other message types like DocumentMessage, just replace the struct type and Message field name.
+// For videos and audio, also copy resp.StreamingSidecar to the StreamingSidecar field, so that recipients can
+// play the media before it's fully downloaded.
 func (cli *Client) Upload(ctx context.Context, plaintext []byte, appInfo MediaType) (resp UploadResponse, err error) {
 	resp.FileLength = uint64(len(plaintext))
 	resp.MediaKey = random.Bytes(32)
@@ -86,6 +107,9 @@ func (cli *Client) Upload(ctx context.Context, plaintext []byte, appInfo MediaTy
 
 	dataHash := sha256.Sum256(dataToUpload)
 	resp.FileEncSHA256 = dataHash[:]
+	if appInfo == MediaVideo || appInfo == MediaAudio {
+		resp.StreamingSidecar = generateStreamingSidecar(iv, dataToUpload, macKey)
+	}
 
 	err = cli.rawUpload(ctx, dataToUpload, resp.FileEncSHA256, appInfo, false, &resp)
 	return
@@ -134,7 +158,20 @@ func (cli *Client) rawUpload(ctx context.Context, dataToUpload, fileHash []byte,
 	if err != nil {
 		return fmt.Errorf("failed to refresh media connections: %w", err)
 	}
+	statusCode, err := cli.uploadWithMediaConn(ctx, mediaConn, dataToUpload, fileHash, appInfo, newsletter, resp)
+	if statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden {
+		// The auth token may have expired before the TTL we know about, so refresh it and try once more
+		cli.Log.Debugf("Media upload failed with status %d, refreshing media connection and retrying", statusCode)
+		mediaConn, err = cli.refreshFailedMediaConn(mediaConn)
+		if err != nil {
+			return fmt.Errorf("failed to refresh media connections after upload auth failure: %w", err)
+		}
+		_, err = cli.uploadWithMediaConn(ctx, mediaConn, dataToUpload, fileHash, appInfo, newsletter, resp)
+	}
+	return err
+}
 
+func (cli *Client) uploadWithMediaConn(ctx context.Context, mediaConn *MediaConn, dataToUpload, fileHash []byte, appInfo MediaType, newsletter bool, resp *UploadResponse) (int, error) {
 	token := base64.URLEncoding.EncodeToString(fileHash)
 	q := url.Values{
 		"auth":  []string{mediaConn.Auth},
@@ -170,16 +207,22 @@ func (cli *Client) rawUpload(ctx context.Context, dataToUpload, fileHash []byte,
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uploadURL.String(), bytes.NewReader(dataToUpload))
 	if err != nil {
-		return fmt.Errorf("failed to prepare request: %w", err)
+		return 0, fmt.Errorf("failed to prepare request: %w", err)
 	}
 
 	req.Header.Set("Origin", socket.Origin)
 	req.Header.Set("Referer", socket.Origin+"/")
 
+	release, err := cli.acquireMediaTransferSlot(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer release()
+	var statusCode int
 	httpResp, err := cli.http.Do(req)
 	if err != nil {
 		err = fmt.Errorf("failed to execute request: %w", err)
-	} else if httpResp.StatusCode != http.StatusOK {
+	} else if statusCode = httpResp.StatusCode; statusCode != http.StatusOK {
 		err = fmt.Errorf("upload failed with status code %d", httpResp.StatusCode)
 	} else if err = json.NewDecoder(httpResp.Body).Decode(&resp); err != nil {
 		err = fmt.Errorf("failed to parse upload response: %w", err)
@@ -187,5 +230,5 @@ func (cli *Client) rawUpload(ctx context.Context, dataToUpload, fileHash []byte,
 	if httpResp != nil {
 		_ = httpResp.Body.Close()
 	}
-	return err
+	return statusCode, err
 }
@@ -11,9 +11,12 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"google.golang.org/protobuf/proto"
 
+	"go.mau.fi/whatsmeow/appstate"
 	waBinary "go.mau.fi/whatsmeow/binary"
 	waProto "go.mau.fi/whatsmeow/binary/proto"
 	"go.mau.fi/whatsmeow/types"
@@ -78,9 +81,15 @@ func (cli *Client) ResolveBusinessMessageLink(code string) (*types.BusinessMessa
 //
 // The links look like https://wa.me/qr/<code> or https://api.whatsapp.com/qr/<code>. You can either provide
 // the full link, or just the <code> part.
+//
+// ErrContactQRLinkRevoked is returned if the owner has revoked the link, ErrContactQRLinkInvalid if the code is malformed
+// and ErrContactQRLinkNotFound if the server doesn't know the code at all.
 func (cli *Client) ResolveContactQRLink(code string) (*types.ContactQRLinkTarget, error) {
 	code = strings.TrimPrefix(code, ContactQRLinkPrefix)
 	code = strings.TrimPrefix(code, ContactQRLinkDirectPrefix)
+	if code == "" || strings.ContainsAny(code, "/?# ") {
+		return nil, ErrContactQRLinkInvalid
+	}
 
 	resp, err := cli.sendIQ(infoQuery{
 		Namespace: "w:qr",
@@ -94,6 +103,10 @@ func (cli *Client) ResolveContactQRLink(code string) (*types.ContactQRLinkTarget
 	})
 	if errors.Is(err, ErrIQNotFound) {
 		return nil, wrapIQError(ErrContactQRLinkNotFound, err)
+	} else if errors.Is(err, ErrIQGone) {
+		return nil, wrapIQError(ErrContactQRLinkRevoked, err)
+	} else if errors.Is(err, ErrIQBadRequest) || errors.Is(err, ErrIQNotAcceptable) {
+		return nil, wrapIQError(ErrContactQRLinkInvalid, err)
 	} else if err != nil {
 		return nil, err
 	}
@@ -112,6 +125,8 @@ func (cli *Client) ResolveContactQRLink(code string) (*types.ContactQRLinkTarget
 // GetContactQRLink gets your own contact share QR link that can be resolved using ResolveContactQRLink
 // (or scanned with the official apps when encoded as a QR code).
 //
+// The returned value is the code part of the link, prepend ContactQRLinkPrefix to get the full shareable link.
+//
 // If the revoke parameter is set to true, it will ask the server to revoke the previous link and generate a new one.
 func (cli *Client) GetContactQRLink(revoke bool) (string, error) {
 	action := "get"
@@ -157,6 +172,31 @@ func (cli *Client) SetStatusMessage(msg string) error {
 	return err
 }
 
+// MaxPushNameLength is the maximum length of push names in characters. Longer names are rejected by SetPushName.
+const MaxPushNameLength = 25
+
+// SetPushName changes the current user's push name, which is the name that other users see
+// if they don't have you in their contact list.
+//
+// The change is sent to the other devices using app state, and the new name is saved in the device store.
+// ErrInvalidPushName is returned if the name is empty or longer than MaxPushNameLength.
+func (cli *Client) SetPushName(name string) error {
+	name = strings.TrimSpace(name)
+	if len(name) == 0 || utf8.RuneCountInString(name) > MaxPushNameLength {
+		return ErrInvalidPushName
+	}
+	err := cli.SendAppState(appstate.BuildSettingPushName(name))
+	if err != nil {
+		return err
+	}
+	cli.Store.PushName = name
+	err = cli.Store.Save()
+	if err != nil {
+		return fmt.Errorf("failed to save device store after updating push name: %w", err)
+	}
+	return nil
+}
+
 // IsOnWhatsApp checks if the given phone numbers are registered on WhatsApp.
 // The phone numbers should be in international format, including the `+` prefix.
 func (cli *Client) IsOnWhatsApp(phones []string) ([]types.IsOnWhatsAppResponse, error) {
@@ -472,19 +512,35 @@ func (cli *Client) handleHistoricalPushNames(names []*waProto.Pushname) {
 		return
 	}
 	cli.Log.Infof("Updating contact store with %d push names from history sync", len(names))
+	synced := make(map[types.JID]string, len(names))
 	for _, user := range names {
-		if user.GetPushname() == "-" {
+		if user.GetPushname() == "" || user.GetPushname() == "-" {
 			continue
 		}
-		var changed bool
-		if jid, err := types.ParseJID(user.GetId()); err != nil {
+		jid, err := types.ParseJID(user.GetId())
+		if err != nil {
 			cli.Log.Warnf("Failed to parse user ID '%s' in push name history sync: %v", user.GetId(), err)
-		} else if changed, _, err = cli.Store.Contacts.PutPushName(jid, user.GetPushname()); err != nil {
-			cli.Log.Warnf("Failed to store push name of %s from history sync: %v", err)
+			continue
+		}
+		jid = jid.ToNonAD()
+		// Names seen in live messages are more recent than the history sync snapshot, so don't overwrite them
+		if existing, err := cli.Store.Contacts.GetContact(jid); err != nil {
+			cli.Log.Warnf("Failed to get existing contact info of %s for push name history sync: %v", jid, err)
+			continue
+		} else if existing.PushName != "" {
+			continue
+		}
+		var changed bool
+		if changed, _, err = cli.Store.Contacts.PutPushName(jid, user.GetPushname()); err != nil {
+			cli.Log.Warnf("Failed to store push name of %s from history sync: %v", jid, err)
 		} else if changed {
 			cli.Log.Debugf("Got push name %s for %s in history sync", user.GetPushname(), jid)
+			synced[jid] = user.GetPushname()
 		}
 	}
+	if len(synced) > 0 {
+		cli.dispatchEvent(&events.PushNamesSynced{Names: synced})
+	}
 }
 
 func (cli *Client) updatePushName(user types.JID, messageInfo *types.MessageInfo, name string) {
@@ -696,7 +752,9 @@ func (cli *Client) GetBlocklist() (*types.Blocklist, error) {
 	if !ok {
 		return nil, &ElementMissingError{Tag: "list", In: "response to blocklist query"}
 	}
-	return cli.parseBlocklist(&list), nil
+	blocklist := cli.parseBlocklist(&list)
+	cli.cacheBlocklist(blocklist)
+	return blocklist, nil
 }
 
 // UpdateBlocklist updates the user's block list and returns the updated list.
@@ -713,9 +771,81 @@ func (cli *Client) UpdateBlocklist(jid types.JID, action events.BlocklistChangeA
 			},
 		}},
 	})
+	if err != nil {
+		return nil, err
+	}
 	list, ok := resp.GetOptionalChildByTag("list")
 	if !ok {
 		return nil, &ElementMissingError{Tag: "list", In: "response to blocklist update"}
 	}
-	return cli.parseBlocklist(&list), err
+	blocklist := cli.parseBlocklist(&list)
+	cli.cacheBlocklist(blocklist)
+	return blocklist, nil
+}
+
+func (cli *Client) cacheBlocklist(blocklist *types.Blocklist) {
+	cache := make(map[types.JID]struct{}, len(blocklist.JIDs))
+	for _, jid := range blocklist.JIDs {
+		cache[jid.ToNonAD()] = struct{}{}
+	}
+	cli.blocklistCacheLock.Lock()
+	cli.blocklistCache = cache
+	cli.blocklistFetchFailedAt = time.Time{}
+	cli.blocklistCacheLock.Unlock()
+}
+
+func (cli *Client) updateBlocklistCache(evt *events.Blocklist) {
+	cli.blocklistCacheLock.Lock()
+	defer cli.blocklistCacheLock.Unlock()
+	if cli.blocklistCache == nil {
+		return
+	} else if evt.Action == events.BlocklistActionModify {
+		// The notification doesn't say what changed, so the list has to be fetched again
+		cli.blocklistCache = nil
+		return
+	}
+	for _, change := range evt.Changes {
+		switch change.Action {
+		case events.BlocklistChangeActionBlock:
+			cli.blocklistCache[change.JID.ToNonAD()] = struct{}{}
+		case events.BlocklistChangeActionUnblock:
+			delete(cli.blocklistCache, change.JID.ToNonAD())
+		}
+	}
+}
+
+// blocklistFetchRetryDelay is how long isBlocked waits before trying to fetch the blocklist again after a failure.
+const blocklistFetchRetryDelay = 5 * time.Minute
+
+func (cli *Client) lookupBlocklistCache(jid types.JID) (blocked, cached bool) {
+	cli.blocklistCacheLock.RLock()
+	defer cli.blocklistCacheLock.RUnlock()
+	if cli.blocklistCache == nil {
+		return false, false
+	}
+	_, blocked = cli.blocklistCache[jid.ToNonAD()]
+	return blocked, true
+}
+
+func (cli *Client) isBlocked(jid types.JID) bool {
+	blocked, cached := cli.lookupBlocklistCache(jid)
+	if cached {
+		return blocked
+	}
+	cli.blocklistCacheLock.RLock()
+	lastFailed := cli.blocklistFetchFailedAt
+	cli.blocklistCacheLock.RUnlock()
+	if !lastFailed.IsZero() && time.Since(lastFailed) < blocklistFetchRetryDelay {
+		return false
+	}
+	_, err := cli.GetBlocklist()
+	if err != nil {
+		cli.Log.Warnf("Failed to fetch blocklist: %v", err)
+		cli.blocklistCacheLock.Lock()
+		cli.blocklistFetchFailedAt = time.Now()
+		cli.blocklistCacheLock.Unlock()
+		return false
+	}
+	blocked, _ = cli.lookupBlocklistCache(jid)
+	return blocked
 }
@@ -0,0 +1,155 @@
+package qrcode
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestEncodeVersionSelection(t *testing.T) {
+	tests := []struct {
+		level   ErrorCorrectionLevel
+		length  int
+		version int
+	}{
+		{Low, 17, 1},
+		{Low, 18, 2},
+		{Medium, 14, 1},
+		{High, 7, 1},
+		{Low, 2953, 40},
+		{High, 1273, 40},
+	}
+	for _, test := range tests {
+		code, err := Encode(strings.Repeat("a", test.length), test.level)
+		if err != nil {
+			t.Errorf("Failed to encode %d bytes at level %d: %v", test.length, test.level, err)
+		} else if code.Version != test.version || code.Size != test.version*4+17 {
+			t.Errorf("Expected version %d for %d bytes at level %d, got %d", test.version, test.length, test.level, code.Version)
+		}
+	}
+	if _, err := Encode(strings.Repeat("a", 2954), Low); !errors.Is(err, ErrDataTooLong) {
+		t.Errorf("Expected ErrDataTooLong, got %v", err)
+	}
+}
+
+func TestReedSolomonRemainder(t *testing.T) {
+	data := []byte("whatsmeow pairing code")
+	const eccLen = 10
+	codeword := append(data, reedSolomonRemainder(data, reedSolomonDivisor(eccLen))...)
+	// A valid codeword evaluates to zero at all the roots of the generator polynomial
+	var root byte = 1
+	for i := 0; i < eccLen; i++ {
+		var syndrome byte
+		for _, coef := range codeword {
+			syndrome = reedSolomonMultiply(syndrome, root) ^ coef
+		}
+		if syndrome != 0 {
+			t.Fatalf("Syndrome %d is non-zero", i)
+		}
+		root = reedSolomonMultiply(root, 0x02)
+	}
+}
+
+func (c *Code) matrixString() string {
+	var sb strings.Builder
+	for y := 0; y < c.Size; y++ {
+		for x := 0; x < c.Size; x++ {
+			if c.Dark(x, y) {
+				sb.WriteByte('#')
+			} else {
+				sb.WriteByte('.')
+			}
+		}
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// goldenVersion1 is "whatsmeow" encoded at level Medium with mask 3 by an independent encoder (rsc.io/qr).
+const goldenVersion1 = "" +
+	"#######.#.##..#######\n" +
+	"#.....#.####..#.....#\n" +
+	"#.###.#.....#.#.###.#\n" +
+	"#.###.#.#.....#.###.#\n" +
+	"#.###.#....#..#.###.#\n" +
+	"#.....#....##.#.....#\n" +
+	"#######.#.#.#.#######\n" +
+	"........#####........\n" +
+	"#.##.###.#.##.#..#.##\n" +
+	"#...#..#.########...#\n" +
+	"##...####.##.#.#.#.##\n" +
+	".#.##......#...###..#\n" +
+	"#...#######.###....#.\n" +
+	"........####.##.##.#.\n" +
+	"#######.#.###.####...\n" +
+	"#.....#.#........###.\n" +
+	"#.###.#..#..#.###.#..\n" +
+	"#.###.#.#..#..#..#.#.\n" +
+	"#.###.#.#.#.#.#...#..\n" +
+	"#.....#......#.##...#\n" +
+	"#######.#.#..#..###..\n"
+
+func TestEncodeGoldenMatrix(t *testing.T) {
+	code, err := encode("whatsmeow", Medium, 3)
+	if err != nil {
+		t.Fatalf("Failed to encode: %v", err)
+	}
+	if matrix := code.matrixString(); matrix != goldenVersion1 {
+		t.Errorf("Matrix doesn't match golden matrix:\n%s", matrix)
+	}
+}
+
+// The SHA-256 hashes of matrices generated by an independent encoder (rsc.io/qr) with the same version, level and mask.
+// The data is the first length bytes of "whatsmeow" repeated, which covers versions with multiple error correction
+// blocks, alignment patterns and version information.
+func TestEncodeGoldenHashes(t *testing.T) {
+	tests := []struct {
+		length  int
+		level   ErrorCorrectionLevel
+		mask    int
+		version int
+		hash    string
+	}{
+		{5, Low, 0, 1, "8ec930a51d477fac2adefd5a49999d5031f828bfa5a9590b7b192bd5c00b6dd6"},
+		{5, Medium, 3, 1, "ef0e823b9b500a40e66d386599fcd7b329a6a7455ff3528f44ffb0aeea8aac0f"},
+		{5, Quartile, 6, 1, "d0b8fb9891900b5685f35edbd7a156dd8ea4b2f96c614c67be65ea9b35d55672"},
+		{5, High, 1, 1, "6a3e5d2713b2fe376709791cdbbc882220608db9fd03698f160ea6f3026da13d"},
+		{20, Low, 4, 2, "10b0fd25ecade3fe95b5ab051ec9fc3e1855c716e85804d2dd545ee3c3833e51"},
+		{20, Medium, 7, 2, "359b7ca024bcf30a78b0be8567e7c85c0a2c5fd58495a02653966445b6b57c47"},
+		{20, Quartile, 2, 2, "396f6265e77f8c49cbcada2ea76da610410fdb4a15c0b7204cfb4f9ff3a718e4"},
+		{20, High, 5, 3, "2130c1b6fceda6e83e13a96fd0784b88b5106d7f27d63d3734f864c4bb1796e6"},
+		{60, Low, 0, 4, "5ea8b772c10a3fafeffbb0110f8f5bf5a4f98c97455ee99262fdaacfff7da52c"},
+		{60, Medium, 3, 4, "9f1a008a0f9cde2208ec03f6ce5067c70873c283411989a498410e672d714888"},
+		{60, Quartile, 6, 5, "0fbb63cc65c539fadaef56c138eca314535c91f9fec37025f0335726737e22e1"},
+		{60, High, 1, 7, "cf36fc8ba4e9a61906e4227bc56896b359b744b36aa5368fe3b56d11fbf7d9c0"},
+		{150, Low, 4, 7, "61f710389e2bd6efc72dbfa6b3bcb514eda4b324b29a38f5806021afdba58ee0"},
+		{150, Medium, 7, 8, "4dd581aa0d08de49dd4bb96a619b18d3d18344613151670a5996140716c12f1f"},
+		{150, Quartile, 2, 10, "0d9489f18f7ccfabd13cc4abdbeb2812e602db5009654688291eeefb24590a5e"},
+		{150, High, 5, 12, "454e6c66a17dd4ffbfc34b670f8015abd1aa51bfe0698e2d2931c753959eab0f"},
+		{400, Low, 0, 13, "aadf2ceafdef38ddb8dc92a580a50350ac4b72c5eb54bc1346e2e6bb6f47f474"},
+		{400, Medium, 3, 15, "e2c55a2b852784ba4628095a979f737b2bcf96253fee8fa82e3869a77657eb99"},
+		{400, Quartile, 6, 19, "1431f8299679e96157ed4ee399387de7625294bbf7b117aed1b96872f8e078e3"},
+		{400, High, 1, 21, "a7f5038395c1237aa986200d60d3b46340742dedafca1583657bbe85d5f6453c"},
+		{1000, Low, 4, 22, "49754bf0155af8510ff93c513360114f9f6594c2327702cf334907e946804103"},
+		{1000, Medium, 7, 26, "da0d194c8654abbc7536508c14afb41cbe622f09432fe54c697b90b5465c64ea"},
+		{1000, Quartile, 2, 31, "6a54421ba0f5bf8981d9e98eae3a35627e3bd23ac7e9f4085dde8745c935a05e"},
+		{1000, High, 5, 36, "ee8203ad9c9af2ba5fc15e42770310a81e0923e8a879dd7ca57207c84c67be2b"},
+	}
+	data := strings.Repeat("whatsmeow", 200)
+	for _, test := range tests {
+		code, err := encode(data[:test.length], test.level, test.mask)
+		if err != nil {
+			t.Errorf("Failed to encode %d bytes at level %d with mask %d: %v", test.length, test.level, test.mask, err)
+			continue
+		} else if code.Version != test.version {
+			t.Errorf("Expected version %d for %d bytes at level %d, got %d", test.version, test.length, test.level, code.Version)
+			continue
+		}
+		hash := sha256.Sum256([]byte(code.matrixString()))
+		if hex.EncodeToString(hash[:]) != test.hash {
+			t.Errorf("Matrix of %d bytes at level %d with mask %d doesn't match golden hash", test.length, test.level, test.mask)
+		}
+	}
+}
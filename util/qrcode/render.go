@@ -0,0 +1,97 @@
+// Copyright (c) 2022 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package qrcode
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+)
+
+// QuietZone is the number of light modules around the code in rendered images, as required by the QR code spec.
+const QuietZone = 4
+
+// Image renders the code as a grayscale image. Each module is scale×scale pixels, and the quiet zone is included.
+func (c *Code) Image(scale int) *image.Gray {
+	scale = max(scale, 1)
+	fullSize := (c.Size + QuietZone*2) * scale
+	img := image.NewGray(image.Rect(0, 0, fullSize, fullSize))
+	for y := 0; y < fullSize; y++ {
+		for x := 0; x < fullSize; x++ {
+			pixel := color.Gray{Y: 0xff}
+			if c.Dark(x/scale-QuietZone, y/scale-QuietZone) {
+				pixel = color.Gray{}
+			}
+			img.SetGray(x, y, pixel)
+		}
+	}
+	return img
+}
+
+// PNG renders the code as a PNG image that is at most the given number of pixels wide and tall
+// (but always at least one pixel per module).
+func (c *Code) PNG(size int) ([]byte, error) {
+	var buf bytes.Buffer
+	err := png.Encode(&buf, c.Image(size/(c.Size+QuietZone*2)))
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Terminal renders the code as text using Unicode half block characters, so that each line contains two rows of modules.
+//
+// Light modules are drawn as blocks and dark modules as spaces, which means the output is meant for terminals with
+// a dark background. The quiet zone is included, but only half as wide as in images to save space.
+func (c *Code) Terminal() string {
+	const quietZone = QuietZone / 2
+	var buf strings.Builder
+	for y := -quietZone; y < c.Size+quietZone; y += 2 {
+		for x := -quietZone; x < c.Size+quietZone; x++ {
+			topLight, bottomLight := !c.Dark(x, y), !c.Dark(x, y+1)
+			if y+1 >= c.Size+quietZone {
+				bottomLight = false
+			}
+			switch {
+			case topLight && bottomLight:
+				buf.WriteString("█")
+			case topLight:
+				buf.WriteString("▀")
+			case bottomLight:
+				buf.WriteString("▄")
+			default:
+				buf.WriteByte(' ')
+			}
+		}
+		buf.WriteByte('\n')
+	}
+	return buf.String()
+}
+
+// EncodePNG encodes the given data as a QR code and renders it as a PNG image that is at most size pixels wide.
+//
+// This can be used directly with the codes in events.QR:
+//
+//	pngData, err := qrcode.EncodePNG(evt.Codes[0], qrcode.Low, 512)
+func EncodePNG(data string, level ErrorCorrectionLevel, size int) ([]byte, error) {
+	code, err := Encode(data, level)
+	if err != nil {
+		return nil, err
+	}
+	return code.PNG(size)
+}
+
+// EncodeTerminal encodes the given data as a QR code and renders it as text for printing in a terminal.
+func EncodeTerminal(data string, level ErrorCorrectionLevel) (string, error) {
+	code, err := Encode(data, level)
+	if err != nil {
+		return "", err
+	}
+	return code.Terminal(), nil
+}
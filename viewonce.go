@@ -0,0 +1,66 @@
+// Copyright (c) 2022 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeow
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/types"
+)
+
+// BuildViewOnce wraps the given image, video or audio message in a ViewOnceMessage.
+// The built message can be sent normally using Client.SendMessage.
+//
+// The given message is cloned, so it can be safely reused after calling this function.
+//
+//	uploaded, err := cli.Upload(context.Background(), data, whatsmeow.MediaImage)
+//	// handle error
+//	msg, err := cli.BuildViewOnce(&waProto.Message{ImageMessage: &waProto.ImageMessage{...}})
+//	// handle error
+//	resp, err := cli.SendMessage(context.Background(), chat, msg)
+func (cli *Client) BuildViewOnce(message *waProto.Message) (*waProto.Message, error) {
+	message = proto.Clone(message).(*waProto.Message)
+	switch {
+	case message.GetImageMessage() != nil:
+		message.ImageMessage.ViewOnce = proto.Bool(true)
+	case message.GetVideoMessage() != nil:
+		message.VideoMessage.ViewOnce = proto.Bool(true)
+	case message.GetAudioMessage() != nil:
+		message.AudioMessage.ViewOnce = proto.Bool(true)
+	default:
+		return nil, ErrViewOnceUnsupportedMessage
+	}
+	return &waProto.Message{
+		ViewOnceMessage: &waProto.FutureProofMessage{
+			Message: message,
+		},
+	}, nil
+}
+
+// SendViewOnce sends the given image, video or audio message as a view once message.
+//
+// This is a shorthand for BuildViewOnce and SendMessage.
+func (cli *Client) SendViewOnce(ctx context.Context, to types.JID, message *waProto.Message, extra ...SendRequestExtra) (resp SendResponse, err error) {
+	wrapped, err := cli.BuildViewOnce(message)
+	if err != nil {
+		return
+	}
+	return cli.SendMessage(ctx, to, wrapped, extra...)
+}
+
+// MarkViewOnceViewed sends a "played" receipt for the given view once message, which tells the sender that the
+// message was opened.
+//
+// The media of view once messages can't be downloaded again after it has been marked as viewed,
+// so make sure to download it (e.g. using Client.Download) before calling this method.
+func (cli *Client) MarkViewOnceViewed(info *types.MessageInfo) error {
+	return cli.MarkRead([]types.MessageID{info.ID}, time.Now(), info.Chat, info.Sender, types.ReceiptTypePlayed)
+}
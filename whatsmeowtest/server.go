@@ -0,0 +1,512 @@
+// Copyright (c) 2022 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package whatsmeowtest implements a fake WhatsApp web server for writing integration tests for whatsmeow and apps using it.
+//
+// The server speaks enough of the websocket, noise and binary XML protocols to let a whatsmeow Client connect,
+// pair and log in. Tests can then inject arbitrary nodes (e.g. messages) and inspect the nodes sent by the client:
+//
+//	srv := whatsmeowtest.NewServer()
+//	defer srv.Close()
+//	cli := whatsmeow.NewClient(deviceStore, nil)
+//	srv.Configure(cli)
+//	err := cli.Connect()
+//	// wait for *events.Connected
+//	err = srv.SendNode(waBinary.Node{Tag: "message", ...})
+//	// assert that the expected *events.Message was dispatched
+//
+// Note that the server doesn't do any end-to-end encryption, so only messages that are sent in plaintext
+// (like newsletter messages) can be injected without implementing the Signal protocol in the test.
+package whatsmeowtest
+
+import (
+	"context"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"go.mau.fi/libsignal/ecc"
+	"google.golang.org/protobuf/proto"
+
+	"go.mau.fi/whatsmeow"
+	waBinary "go.mau.fi/whatsmeow/binary"
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/socket"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/util/keys"
+)
+
+// ErrNotConnected is returned by Server methods that need a client connection when no client is connected.
+var ErrNotConnected = errors.New("no client connected to the fake server")
+
+// IQHandler is a function that responds to info queries sent by the client.
+// If the function returns nil, the default response is sent instead.
+type IQHandler func(iq *waBinary.Node) *waBinary.Node
+
+// Server is a fake WhatsApp web websocket server.
+type Server struct {
+	// IQHandler can be set to respond to info queries with custom data.
+	IQHandler IQHandler
+	// PreKeyCount is the number of prekeys the server reports to the client. Defaults to 50,
+	// which means the client won't try to upload more prekeys after connecting.
+	PreKeyCount int
+	// Received contains the nodes sent by the client after the handshake. If the channel is full, new nodes are dropped.
+	Received chan *waBinary.Node
+
+	httpServer  *httptest.Server
+	rootKey     *keys.KeyPair
+	staticKey   *keys.KeyPair
+	certChain   []byte
+	upgrader    websocket.Upgrader
+	idCounter   atomic.Uint64
+	conn        *serverConn
+	connLock    sync.Mutex
+	connected   chan struct{}
+	lastPayload *waProto.ClientPayload
+}
+
+type serverConn struct {
+	ws           *websocket.Conn
+	writeKey     cipher.AEAD
+	readKey      cipher.AEAD
+	writeCounter uint32
+	readCounter  uint32
+	writeLock    sync.Mutex
+	incoming     []byte
+	gotHeader    bool
+}
+
+// NewServer starts a new fake server on a random local port.
+func NewServer() *Server {
+	srv := &Server{
+		PreKeyCount: 50,
+		Received:    make(chan *waBinary.Node, 256),
+		rootKey:     keys.NewKeyPair(),
+		staticKey:   keys.NewKeyPair(),
+		connected:   make(chan struct{}),
+		upgrader: websocket.Upgrader{
+			// The client always sends the web.whatsapp.com origin
+			CheckOrigin: func(r *http.Request) bool { return true },
+		},
+	}
+	srv.certChain = srv.makeCertChain()
+	srv.httpServer = httptest.NewServer(http.HandlerFunc(srv.handleWebsocket))
+	return srv
+}
+
+func signCertDetails(signer *keys.KeyPair, details *waProto.CertChain_NoiseCertificate_Details) *waProto.CertChain_NoiseCertificate {
+	detailsBytes, _ := proto.Marshal(details)
+	signature := ecc.CalculateSignature(ecc.NewDjbECPrivateKey(*signer.Priv), detailsBytes)
+	return &waProto.CertChain_NoiseCertificate{
+		Details:   detailsBytes,
+		Signature: signature[:],
+	}
+}
+
+func (srv *Server) makeCertChain() []byte {
+	intermediateKey := keys.NewKeyPair()
+	certChain, _ := proto.Marshal(&waProto.CertChain{
+		Intermediate: signCertDetails(srv.rootKey, &waProto.CertChain_NoiseCertificate_Details{
+			Serial:       proto.Uint32(1),
+			IssuerSerial: proto.Uint32(0),
+			Key:          intermediateKey.Pub[:],
+		}),
+		Leaf: signCertDetails(intermediateKey, &waProto.CertChain_NoiseCertificate_Details{
+			Serial:       proto.Uint32(2),
+			IssuerSerial: proto.Uint32(1),
+			Key:          srv.staticKey.Pub[:],
+		}),
+	})
+	return certChain
+}
+
+// URL returns the websocket URL of the server.
+func (srv *Server) URL() string {
+	return "ws" + strings.TrimPrefix(srv.httpServer.URL, "http") + "/ws/chat"
+}
+
+// RootKey returns the public key that the noise certificate of the server is signed with.
+func (srv *Server) RootKey() *[32]byte {
+	return srv.rootKey.Pub
+}
+
+// Configure sets the websocket URL and noise certificate root key of the given client to point at this server.
+func (srv *Server) Configure(cli *whatsmeow.Client) {
+	cli.WebsocketURL = srv.URL()
+	cli.NoiseCertRootKey = srv.RootKey()
+}
+
+// Close disconnects the current client and stops the server.
+func (srv *Server) Close() {
+	srv.Disconnect()
+	srv.httpServer.Close()
+}
+
+// Disconnect closes the websocket connection of the current client without sending a stream end.
+func (srv *Server) Disconnect() {
+	srv.connLock.Lock()
+	conn := srv.conn
+	srv.conn = nil
+	srv.connLock.Unlock()
+	if conn != nil {
+		_ = conn.ws.Close()
+	}
+}
+
+// ClientPayload returns the client payload sent by the client in the most recent handshake.
+func (srv *Server) ClientPayload() *waProto.ClientPayload {
+	srv.connLock.Lock()
+	defer srv.connLock.Unlock()
+	return srv.lastPayload
+}
+
+// WaitForConnection waits until a client has completed the handshake with the server.
+func (srv *Server) WaitForConnection(ctx context.Context) error {
+	srv.connLock.Lock()
+	ch := srv.connected
+	srv.connLock.Unlock()
+	select {
+	case <-ch:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// WaitForNode waits until the client sends a node that matches the given filter function.
+// Nodes that don't match are discarded.
+func (srv *Server) WaitForNode(ctx context.Context, filter func(node *waBinary.Node) bool) (*waBinary.Node, error) {
+	for {
+		select {
+		case node := <-srv.Received:
+			if filter(node) {
+				return node, nil
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// GenerateMessageID generates a random ID for nodes sent to the client.
+func (srv *Server) GenerateMessageID() string {
+	return "fake-" + strconv.FormatUint(srv.idCounter.Add(1), 10)
+}
+
+// SendNode sends the given node to the currently connected client.
+func (srv *Server) SendNode(node waBinary.Node) error {
+	srv.connLock.Lock()
+	conn := srv.conn
+	srv.connLock.Unlock()
+	if conn == nil {
+		return ErrNotConnected
+	}
+	payload, err := waBinary.Marshal(node)
+	if err != nil {
+		return fmt.Errorf("failed to marshal node: %w", err)
+	}
+	return conn.sendEncryptedFrame(payload)
+}
+
+// Pair acts as the primary device scanning the given QR code (see whatsmeow.Client.GetQRChannel) and pairs
+// the client as the given device JID. After the client confirms the pairing, the server asks it to reconnect,
+// like the real server does. Wait for *events.PairSuccess and *events.Connected on the client to know when it's done.
+func (srv *Server) Pair(qrCode string, jid types.JID) error {
+	parts := strings.Split(qrCode, ",")
+	if len(parts) != 4 {
+		return fmt.Errorf("invalid QR code: expected 4 parts, got %d", len(parts))
+	}
+	identityKey, err := base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return fmt.Errorf("failed to decode identity key in QR code: %w", err)
+	}
+	advSecret, err := base64.StdEncoding.DecodeString(parts[3])
+	if err != nil {
+		return fmt.Errorf("failed to decode adv secret in QR code: %w", err)
+	}
+	accountKey := keys.NewKeyPair()
+	details, _ := proto.Marshal(&waProto.ADVDeviceIdentity{
+		RawId:     proto.Uint32(1),
+		Timestamp: proto.Uint64(uint64(time.Now().Unix())),
+		KeyIndex:  proto.Uint32(1),
+	})
+	signatureMessage := append(append([]byte{6, 0}, details...), identityKey...)
+	accountSignature := ecc.CalculateSignature(ecc.NewDjbECPrivateKey(*accountKey.Priv), signatureMessage)
+	signedIdentity, _ := proto.Marshal(&waProto.ADVSignedDeviceIdentity{
+		Details:             details,
+		AccountSignatureKey: accountKey.Pub[:],
+		AccountSignature:    accountSignature[:],
+	})
+	h := hmac.New(sha256.New, advSecret)
+	h.Write(signedIdentity)
+	identityContainer, _ := proto.Marshal(&waProto.ADVSignedDeviceIdentityHMAC{
+		Details: signedIdentity,
+		Hmac:    h.Sum(nil),
+	})
+	return srv.SendNode(waBinary.Node{
+		Tag: "iq",
+		Attrs: waBinary.Attrs{
+			"from": types.ServerJID,
+			"type": "set",
+			"id":   srv.GenerateMessageID(),
+		},
+		Content: []waBinary.Node{{
+			Tag: "pair-success",
+			Content: []waBinary.Node{
+				{Tag: "device-identity", Content: identityContainer},
+				{Tag: "device", Attrs: waBinary.Attrs{"jid": jid}},
+				{Tag: "platform", Attrs: waBinary.Attrs{"name": "whatsmeowtest"}},
+			},
+		}},
+	})
+}
+
+func (srv *Server) handleWebsocket(w http.ResponseWriter, r *http.Request) {
+	ws, err := srv.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	conn := &serverConn{ws: ws}
+	defer ws.Close()
+	payload, err := conn.handshake(srv)
+	if err != nil {
+		return
+	}
+	srv.connLock.Lock()
+	if srv.conn != nil {
+		_ = srv.conn.ws.Close()
+	}
+	srv.conn = conn
+	srv.lastPayload = payload
+	close(srv.connected)
+	srv.connected = make(chan struct{})
+	srv.connLock.Unlock()
+
+	if payload.GetDevicePairingData() != nil {
+		err = srv.SendNode(waBinary.Node{
+			Tag: "iq",
+			Attrs: waBinary.Attrs{
+				"from": types.ServerJID,
+				"type": "set",
+				"id":   srv.GenerateMessageID(),
+			},
+			Content: []waBinary.Node{{
+				Tag:     "pair-device",
+				Content: []waBinary.Node{{Tag: "ref", Content: []byte(srv.GenerateMessageID())}},
+			}},
+		})
+	} else {
+		err = srv.SendNode(waBinary.Node{
+			Tag:   "success",
+			Attrs: waBinary.Attrs{"t": time.Now().Unix()},
+		})
+	}
+	if err != nil {
+		return
+	}
+	for {
+		frame, err := conn.readEncryptedFrame()
+		if err != nil {
+			return
+		}
+		data, err := waBinary.Unpack(frame)
+		if err != nil {
+			continue
+		}
+		node, err := waBinary.Unmarshal(data)
+		if err != nil {
+			continue
+		}
+		srv.handleNode(node)
+	}
+}
+
+func (srv *Server) handleNode(node *waBinary.Node) {
+	select {
+	case srv.Received <- node:
+	default:
+	}
+	if node.Tag != "iq" {
+		return
+	}
+	ag := node.AttrGetter()
+	iqType := ag.String("type")
+	if iqType == "result" {
+		if _, ok := node.GetOptionalChildByTag("pair-device-sign"); ok {
+			// The real server asks the client to reconnect after pairing
+			_ = srv.SendNode(waBinary.Node{Tag: "stream:error", Attrs: waBinary.Attrs{"code": "515"}})
+		}
+		return
+	} else if iqType != "get" && iqType != "set" {
+		return
+	}
+	var resp *waBinary.Node
+	if srv.IQHandler != nil {
+		resp = srv.IQHandler(node)
+	}
+	if resp == nil {
+		resp = srv.defaultIQResponse(node)
+	}
+	_ = srv.SendNode(*resp)
+}
+
+func (srv *Server) defaultIQResponse(node *waBinary.Node) *waBinary.Node {
+	resp := &waBinary.Node{
+		Tag: "iq",
+		Attrs: waBinary.Attrs{
+			"from": types.ServerJID,
+			"type": "result",
+			"id":   node.Attrs["id"],
+		},
+	}
+	if node.Attrs["xmlns"] == "encrypt" && node.Attrs["type"] == "get" {
+		if _, ok := node.GetOptionalChildByTag("count"); ok {
+			resp.Content = []waBinary.Node{{
+				Tag:   "count",
+				Attrs: waBinary.Attrs{"value": srv.PreKeyCount},
+			}}
+		}
+	}
+	return resp
+}
+
+// handshake implements the responder side of the Noise_XX_25519_AESGCM_SHA256 handshake (see Client.doHandshake).
+func (conn *serverConn) handshake(srv *Server) (*waProto.ClientPayload, error) {
+	nh := socket.NewNoiseHandshake()
+	nh.Start(socket.NoiseStartPattern, socket.WAConnHeader)
+	frame, err := conn.readFrame()
+	if err != nil {
+		return nil, err
+	}
+	var clientHello waProto.HandshakeMessage
+	if err = proto.Unmarshal(frame, &clientHello); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal client hello: %w", err)
+	}
+	clientEphemeral := clientHello.GetClientHello().GetEphemeral()
+	if len(clientEphemeral) != 32 {
+		return nil, fmt.Errorf("invalid client ephemeral key length %d", len(clientEphemeral))
+	}
+	nh.Authenticate(clientEphemeral)
+
+	ephemeralKey := keys.NewKeyPair()
+	nh.Authenticate(ephemeralKey.Pub[:])
+	if err = nh.MixSharedSecretIntoKey(*ephemeralKey.Priv, [32]byte(clientEphemeral)); err != nil {
+		return nil, err
+	}
+	staticCiphertext := nh.Encrypt(srv.staticKey.Pub[:])
+	if err = nh.MixSharedSecretIntoKey(*srv.staticKey.Priv, [32]byte(clientEphemeral)); err != nil {
+		return nil, err
+	}
+	certCiphertext := nh.Encrypt(srv.certChain)
+	serverHello, _ := proto.Marshal(&waProto.HandshakeMessage{
+		ServerHello: &waProto.HandshakeServerHello{
+			Ephemeral: ephemeralKey.Pub[:],
+			Static:    staticCiphertext,
+			Payload:   certCiphertext,
+		},
+	})
+	if err = conn.sendFrame(serverHello); err != nil {
+		return nil, err
+	}
+
+	frame, err = conn.readFrame()
+	if err != nil {
+		return nil, err
+	}
+	var clientFinish waProto.HandshakeMessage
+	if err = proto.Unmarshal(frame, &clientFinish); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal client finish: %w", err)
+	}
+	clientStatic, err := nh.Decrypt(clientFinish.GetClientFinish().GetStatic())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt client static key: %w", err)
+	} else if len(clientStatic) != 32 {
+		return nil, fmt.Errorf("invalid client static key length %d", len(clientStatic))
+	}
+	if err = nh.MixSharedSecretIntoKey(*ephemeralKey.Priv, [32]byte(clientStatic)); err != nil {
+		return nil, err
+	}
+	payloadBytes, err := nh.Decrypt(clientFinish.GetClientFinish().GetPayload())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt client payload: %w", err)
+	}
+	var payload waProto.ClientPayload
+	if err = proto.Unmarshal(payloadBytes, &payload); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal client payload: %w", err)
+	}
+	// The keys are from the client's point of view, so they're swapped here
+	conn.readKey, conn.writeKey, err = nh.FinishKeys()
+	if err != nil {
+		return nil, err
+	}
+	return &payload, nil
+}
+
+func (conn *serverConn) readFrame() ([]byte, error) {
+	for {
+		if !conn.gotHeader && len(conn.incoming) >= len(socket.WAConnHeader) {
+			conn.incoming = conn.incoming[len(socket.WAConnHeader):]
+			conn.gotHeader = true
+		}
+		if conn.gotHeader && len(conn.incoming) >= socket.FrameLengthSize {
+			length := int(conn.incoming[0])<<16 | int(conn.incoming[1])<<8 | int(conn.incoming[2])
+			if len(conn.incoming) >= socket.FrameLengthSize+length {
+				frame := conn.incoming[socket.FrameLengthSize : socket.FrameLengthSize+length]
+				conn.incoming = conn.incoming[socket.FrameLengthSize+length:]
+				return frame, nil
+			}
+		}
+		_, data, err := conn.ws.ReadMessage()
+		if err != nil {
+			return nil, err
+		}
+		conn.incoming = append(conn.incoming, data...)
+	}
+}
+
+func (conn *serverConn) sendFrame(data []byte) error {
+	frame := make([]byte, socket.FrameLengthSize+len(data))
+	frame[0] = byte(len(data) >> 16)
+	binary.BigEndian.PutUint16(frame[1:3], uint16(len(data)))
+	copy(frame[socket.FrameLengthSize:], data)
+	return conn.ws.WriteMessage(websocket.BinaryMessage, frame)
+}
+
+func generateIV(count uint32) []byte {
+	iv := make([]byte, 12)
+	binary.BigEndian.PutUint32(iv[8:], count)
+	return iv
+}
+
+func (conn *serverConn) readEncryptedFrame() ([]byte, error) {
+	ciphertext, err := conn.readFrame()
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := conn.readKey.Open(nil, generateIV(conn.readCounter), ciphertext, nil)
+	conn.readCounter++
+	return plaintext, err
+}
+
+func (conn *serverConn) sendEncryptedFrame(plaintext []byte) error {
+	conn.writeLock.Lock()
+	defer conn.writeLock.Unlock()
+	ciphertext := conn.writeKey.Seal(nil, generateIV(conn.writeCounter), plaintext, nil)
+	conn.writeCounter++
+	return conn.sendFrame(ciphertext)
+}
@@ -0,0 +1,224 @@
+// Copyright (c) 2022 Tulir Asokan
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package whatsmeowtest_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"go.mau.fi/whatsmeow"
+	waBinary "go.mau.fi/whatsmeow/binary"
+	waProto "go.mau.fi/whatsmeow/binary/proto"
+	"go.mau.fi/whatsmeow/store"
+	"go.mau.fi/whatsmeow/types"
+	"go.mau.fi/whatsmeow/types/events"
+	"go.mau.fi/whatsmeow/util/keys"
+	"go.mau.fi/whatsmeow/whatsmeowtest"
+)
+
+type memoryPreKeyStore struct{}
+
+func (memoryPreKeyStore) GetOrGenPreKeys(count uint32) ([]*keys.PreKey, error) { return nil, nil }
+func (memoryPreKeyStore) GenOnePreKey() (*keys.PreKey, error)                  { return keys.NewPreKey(1), nil }
+func (memoryPreKeyStore) GetPreKey(id uint32) (*keys.PreKey, error)            { return nil, nil }
+func (memoryPreKeyStore) RemovePreKey(id uint32) error                         { return nil }
+func (memoryPreKeyStore) MarkPreKeysAsUploaded(upToID uint32) error            { return nil }
+func (memoryPreKeyStore) UploadedPreKeyCount() (int, error)                    { return 50, nil }
+
+func newTestDevice() *store.Device {
+	identityKey := keys.NewKeyPair()
+	return &store.Device{
+		NoiseKey:       keys.NewKeyPair(),
+		IdentityKey:    identityKey,
+		SignedPreKey:   identityKey.CreateSignedPreKey(1),
+		RegistrationID: 1,
+		ID:             &types.JID{User: "1234567890", Device: 1, Server: types.DefaultUserServer},
+		PreKeys:        memoryPreKeyStore{},
+		Initialized:    true,
+	}
+}
+
+func TestReceiveNewsletterMessage(t *testing.T) {
+	srv := whatsmeowtest.NewServer()
+	defer srv.Close()
+	cli := whatsmeow.NewClient(newTestDevice(), nil)
+	cli.EnableAutoReconnect = false
+	srv.Configure(cli)
+
+	evts := make(chan any, 16)
+	cli.AddEventHandler(func(evt any) {
+		evts <- evt
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	waitForEvent := func(filter func(evt any) bool) any {
+		for {
+			select {
+			case evt := <-evts:
+				if filter(evt) {
+					return evt
+				}
+			case <-ctx.Done():
+				t.Fatal("Timed out waiting for event")
+			}
+		}
+	}
+
+	if err := cli.Connect(); err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer cli.Disconnect()
+	waitForEvent(func(evt any) bool {
+		_, ok := evt.(*events.Connected)
+		return ok
+	})
+
+	plaintext, _ := proto.Marshal(&waProto.Message{Conversation: proto.String("Hello from the fake server")})
+	err := srv.SendNode(waBinary.Node{
+		Tag: "message",
+		Attrs: waBinary.Attrs{
+			"from":      types.NewJID("123456789", types.NewsletterServer),
+			"id":        srv.GenerateMessageID(),
+			"server_id": "100",
+			"type":      "text",
+			"t":         time.Now().Unix(),
+		},
+		Content: []waBinary.Node{{Tag: "plaintext", Content: plaintext}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to send message node: %v", err)
+	}
+	msg := waitForEvent(func(evt any) bool {
+		_, ok := evt.(*events.Message)
+		return ok
+	}).(*events.Message)
+	if msg.Message.GetConversation() != "Hello from the fake server" {
+		t.Errorf("Unexpected message content %q", msg.Message.GetConversation())
+	}
+}
+
+func TestNoiseCertificateIsVerified(t *testing.T) {
+	srv := whatsmeowtest.NewServer()
+	defer srv.Close()
+	cli := whatsmeow.NewClient(newTestDevice(), nil)
+	cli.EnableAutoReconnect = false
+	cli.WebsocketURL = srv.URL()
+	// Don't trust the root key of the fake server, which means the handshake must fail
+	wrongRootKey := keys.NewKeyPair().Pub
+	cli.NoiseCertRootKey = wrongRootKey
+	if err := cli.Connect(); err == nil {
+		cli.Disconnect()
+		t.Fatal("Connecting succeeded even though the server certificate isn't signed by the trusted root key")
+	}
+
+	cli.WebsocketURL = "https" + strings.TrimPrefix(srv.URL(), "ws")
+	if err := cli.Connect(); !errors.Is(err, whatsmeow.ErrInvalidWebsocketURL) {
+		t.Fatalf("Expected ErrInvalidWebsocketURL for non-websocket URL, got %v", err)
+	}
+}
+
+func TestDisconnectGracefully(t *testing.T) {
+	srv := whatsmeowtest.NewServer()
+	defer srv.Close()
+	cli := whatsmeow.NewClient(newTestDevice(), nil)
+	srv.Configure(cli)
+	connected := make(chan struct{}, 1)
+	disconnected := make(chan *events.Disconnected, 1)
+	cli.AddEventHandler(func(evt any) {
+		switch typedEvt := evt.(type) {
+		case *events.Connected:
+			connected <- struct{}{}
+		case *events.Disconnected:
+			disconnected <- typedEvt
+		}
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := cli.Connect(); err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	select {
+	case <-connected:
+	case <-ctx.Done():
+		t.Fatal("Timed out waiting for connection")
+	}
+	if err := cli.DisconnectGracefully(ctx); err != nil {
+		t.Fatalf("Failed to disconnect gracefully: %v", err)
+	}
+	select {
+	case evt := <-disconnected:
+		if !evt.Graceful {
+			t.Error("Disconnected event wasn't marked as graceful")
+		}
+	default:
+		t.Error("Disconnected event wasn't dispatched")
+	}
+	if cli.IsConnected() {
+		t.Error("Client is still connected")
+	}
+}
+
+func TestDisconnectGracefullyFromEventHandler(t *testing.T) {
+	srv := whatsmeowtest.NewServer()
+	defer srv.Close()
+	cli := whatsmeow.NewClient(newTestDevice(), nil)
+	cli.EnableAutoReconnect = false
+	srv.Configure(cli)
+	connected := make(chan struct{}, 1)
+	disconnectErr := make(chan error, 1)
+	cli.AddEventHandler(func(evt any) {
+		switch evt.(type) {
+		case *events.Connected:
+			connected <- struct{}{}
+		case *events.Message:
+			go func() {
+				ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+				disconnectErr <- cli.DisconnectGracefully(ctx)
+			}()
+		}
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := cli.Connect(); err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer cli.Disconnect()
+	select {
+	case <-connected:
+	case <-ctx.Done():
+		t.Fatal("Timed out waiting for connection")
+	}
+	plaintext, _ := proto.Marshal(&waProto.Message{Conversation: proto.String("Disconnect now")})
+	err := srv.SendNode(waBinary.Node{
+		Tag: "message",
+		Attrs: waBinary.Attrs{
+			"from":      types.NewJID("123456789", types.NewsletterServer),
+			"id":        srv.GenerateMessageID(),
+			"server_id": "100",
+			"type":      "text",
+			"t":         time.Now().Unix(),
+		},
+		Content: []waBinary.Node{{Tag: "plaintext", Content: plaintext}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to send message node: %v", err)
+	}
+	select {
+	case err = <-disconnectErr:
+		if err != nil {
+			t.Errorf("Graceful disconnect from event handler failed: %v", err)
+		}
+	case <-ctx.Done():
+		t.Fatal("Timed out waiting for graceful disconnect")
+	}
+}